@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/apikey"
+	"messager/infrastructure/database/postgresql"
+)
+
+// Authenticate returns the key a request presents the secret of.
+func (s *service) Authenticate(ctx context.Context, secret string) (*apikey.APIKey, error) {
+	var key apikey.APIKey
+
+	if secret == "" {
+		return nil, key.NewErrAPIKeyUnauthenticated()
+	}
+
+	foundKey, err := s.repository.FindByHash(ctx, apikey.Hash(secret))
+	if foundKey == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return nil, key.NewErrAPIKeyUnauthenticated()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindByHash(): %w", err)
+	}
+
+	return foundKey, nil
+}
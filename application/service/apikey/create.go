@@ -0,0 +1,52 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/apikey"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// Create issues a key for the tenant of ctx. The default tenant may issue keys
+// for other tenants too. The secret of the key is only returned here.
+func (s *service) Create(ctx context.Context, key apikey.APIKey) (*apikey.APIKey, error) {
+	if err := key.ValidateForCreate(); err != nil {
+		return nil, errors.Join(key.NewErrAPIKeyDoesNotValidForCreate(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	if key.TenantID == "" {
+		key.TenantID = current.ID
+	}
+
+	if key.TenantID != current.ID {
+		if !current.IsDefault() {
+			return nil, current.NewErrTenantForbidden()
+		}
+
+		_, err := s.tenantRepository.FindByID(ctx, key.TenantID)
+		if errors.Is(err, postgresql.ErrNoRows) {
+			return nil, current.NewErrTenantNotFound()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("service.tenantRepository.FindByID(): %w", err)
+		}
+	}
+
+	if err := key.Generate(); err != nil {
+		return nil, fmt.Errorf("apikey.Generate(): %w", err)
+	}
+
+	if err := s.repository.Create(ctx, &key); err != nil {
+		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+	}
+
+	return &key, nil
+}
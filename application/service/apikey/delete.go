@@ -0,0 +1,38 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/apikey"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// Delete revokes a key of the tenant of ctx. Requests with it are refused
+// from then on.
+func (s *service) Delete(ctx context.Context, id string) error {
+	key := apikey.APIKey{
+		ID: id,
+	}
+
+	if err := key.ValidateForFind(); err != nil {
+		return errors.Join(key.NewErrAPIKeyDoesNotValidForFind(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	err := s.repository.Delete(ctx, current.ID, id)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return key.NewErrAPIKeyNotFound()
+	}
+	if err != nil {
+		return fmt.Errorf("service.repository.Delete(): %w", err)
+	}
+
+	return nil
+}
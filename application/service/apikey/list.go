@@ -0,0 +1,23 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/apikey"
+	"messager/domain/tenant"
+)
+
+func (s *service) List(ctx context.Context) ([]apikey.APIKey, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	keys, err := s.repository.FindAll(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAll(): %w", err)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,18 @@
+package apikey
+
+import (
+	"messager/domain/apikey"
+	"messager/domain/tenant"
+)
+
+type service struct {
+	repository       apikey.Repository
+	tenantRepository tenant.Repository
+}
+
+func New(repository apikey.Repository, tenantRepository tenant.Repository) apikey.Service {
+	return &service{
+		repository:       repository,
+		tenantRepository: tenantRepository,
+	}
+}
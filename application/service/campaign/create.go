@@ -0,0 +1,95 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+	"messager/domain/tenant"
+)
+
+// Create persists the campaign as a draft together with a message for every
+// valid recipient. Invalid recipients are reported as rejections. Nothing is
+// kept when no recipient is valid or the messages cannot be persisted, and the
+// quota reserved for the messages already created is released.
+func (s *service) Create(ctx context.Context, campaign campaign.Campaign) (*campaign.Campaign, error) {
+	if err := campaign.ValidateForCreate(s.config.MaxRecipients); err != nil {
+		return nil, errors.Join(campaign.NewErrCampaignDoesNotValidForCreate(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	campaign.NormalizeForCreate()
+	campaign.TenantID = current.ID
+
+	if err := s.repository.Create(ctx, &campaign); err != nil {
+		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+	}
+
+	created, err := s.expand(ctx, &campaign)
+	if err != nil {
+		if deleteErr := s.repository.Delete(ctx, campaign.TenantID, campaign.ID); deleteErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("service.repository.Delete(): %w", deleteErr))
+		}
+
+		if created > 0 {
+			if releaseErr := s.messageService.ReleaseQuota(ctx, created); releaseErr != nil {
+				return nil, errors.Join(err, fmt.Errorf("service.messageService.ReleaseQuota(): %w", releaseErr))
+			}
+		}
+
+		return nil, err
+	}
+
+	return &campaign, nil
+}
+
+// expand creates the messages of the campaign in batches and returns how many
+// were created.
+func (s *service) expand(ctx context.Context, campaign *campaign.Campaign) (int, error) {
+	var created int
+
+	messages := campaign.Messages()
+
+	for start := 0; start < len(messages); start += s.config.BatchSize {
+		end := min(start+s.config.BatchSize, len(messages))
+
+		results, err := s.messageService.CreateBatch(ctx, messages[start:end])
+		if err != nil {
+			return created, fmt.Errorf("service.messageService.CreateBatch(): %w", err)
+		}
+
+		for i, result := range results {
+			if result.Err != nil {
+				campaign.Rejections = append(campaign.Rejections, newRejection(start+i, messages[start+i], result.Err))
+
+				continue
+			}
+
+			created++
+		}
+	}
+
+	if len(campaign.Rejections) == len(messages) {
+		return created, errors.Join(
+			campaign.NewErrCampaignDoesNotValidForCreate(),
+			errors.New("campaign must have at least one valid recipient"),
+			campaign.Rejections[0].Err,
+		)
+	}
+
+	return created, nil
+}
+
+func newRejection(index int, message message.Message, err error) campaign.Rejection {
+	return campaign.Rejection{
+		Index: index,
+		Phone: message.Phone,
+		Err:   err,
+	}
+}
@@ -0,0 +1,52 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Get(ctx context.Context, id string) (*campaign.Campaign, error) {
+	foundCampaign, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.repository.FindProgressByID(ctx, foundCampaign.TenantID, id)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindProgressByID(): %w", err)
+	}
+
+	foundCampaign.Progress = progress
+
+	return foundCampaign, nil
+}
+
+func (s *service) find(ctx context.Context, id string) (*campaign.Campaign, error) {
+	campaign := campaign.Campaign{
+		ID: id,
+	}
+
+	if err := campaign.ValidateForFind(); err != nil {
+		return nil, errors.Join(campaign.NewErrCampaignDoesNotValidForFind(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	foundCampaign, err := s.repository.FindByID(ctx, current.ID, id)
+	if foundCampaign == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return nil, campaign.NewErrCampaignNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindByID(): %w", err)
+	}
+
+	return foundCampaign, nil
+}
@@ -0,0 +1,23 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/domain/tenant"
+)
+
+func (s *service) List(ctx context.Context) ([]campaign.Campaign, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	campaigns, err := s.repository.FindAll(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAll(): %w", err)
+	}
+
+	return campaigns, nil
+}
@@ -0,0 +1,25 @@
+package campaign
+
+import (
+	"messager/domain/campaign"
+	"messager/domain/message"
+)
+
+type Config struct {
+	MaxRecipients int
+	BatchSize     int
+}
+
+type service struct {
+	repository     campaign.Repository
+	messageService message.Service
+	config         *Config
+}
+
+func New(repository campaign.Repository, messageService message.Service, config Config) campaign.Service {
+	return &service{
+		repository:     repository,
+		messageService: messageService,
+		config:         &config,
+	}
+}
@@ -0,0 +1,250 @@
+package campaign_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"messager/application/service/campaign"
+	entity "messager/domain/campaign"
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+var testConfig = campaign.Config{
+	MaxRecipients: 10,
+	BatchSize:     2,
+}
+
+type mockRepository struct {
+	mock.Mock
+}
+
+func (m *mockRepository) Create(ctx context.Context, c *entity.Campaign) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *mockRepository) FindAll(ctx context.Context, tenantID string) ([]entity.Campaign, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]entity.Campaign), args.Error(1)
+}
+
+func (m *mockRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.Campaign, error) {
+	args := m.Called(ctx, tenantID, id)
+	return args.Get(0).(*entity.Campaign), args.Error(1)
+}
+
+func (m *mockRepository) FindProgressByID(ctx context.Context, tenantID, id string) (entity.Progress, error) {
+	args := m.Called(ctx, tenantID, id)
+	return args.Get(0).(entity.Progress), args.Error(1)
+}
+
+func (m *mockRepository) UpdateStatus(ctx context.Context, tenantID, id string, from, to entity.Status) error {
+	args := m.Called(ctx, tenantID, id, from, to)
+	return args.Error(0)
+}
+
+func (m *mockRepository) Cancel(ctx context.Context, tenantID, id string, from entity.Status) error {
+	args := m.Called(ctx, tenantID, id, from)
+	return args.Error(0)
+}
+
+func (m *mockRepository) Delete(ctx context.Context, tenantID, id string) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+type mockMessageService struct {
+	message.Service
+	mock.Mock
+}
+
+func (m *mockMessageService) CreateBatch(ctx context.Context, msgs []message.Message) ([]message.CreateResult, error) {
+	args := m.Called(ctx, msgs)
+	return args.Get(0).([]message.CreateResult), args.Error(1)
+}
+
+func (m *mockMessageService) ReleaseQuota(ctx context.Context, count int) error {
+	args := m.Called(ctx, count)
+	return args.Error(0)
+}
+
+func validCampaign() entity.Campaign {
+	return entity.Campaign{
+		Name:    "Black Friday",
+		Content: "Everything is 50% off today!",
+		Recipients: []entity.Recipient{
+			{Phone: "+905551234567"},
+			{Phone: "+905551234568"},
+			{Phone: "invalid"},
+		},
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+	id := uuid.New().String()
+	setID := func(args mock.Arguments) { args.Get(1).(*entity.Campaign).ID = id }
+
+	t.Run("success with rejections", func(t *testing.T) {
+		repo := new(mockRepository)
+		messages := new(mockMessageService)
+		repo.On("Create", ctx, mock.AnythingOfType("*campaign.Campaign")).Run(setID).Return(nil)
+		messages.On("CreateBatch", ctx, mock.MatchedBy(func(msgs []message.Message) bool {
+			return len(msgs) == 2 && msgs[0].CampaignID == id
+		})).Return([]message.CreateResult{{Message: &message.Message{}}, {Message: &message.Message{}}}, nil)
+		messages.On("CreateBatch", ctx, mock.MatchedBy(func(msgs []message.Message) bool {
+			return len(msgs) == 1
+		})).Return([]message.CreateResult{{Err: message.ErrMessageDoesNotValidForCreate}}, nil)
+		svc := campaign.New(repo, messages, testConfig)
+		got, err := svc.Create(ctx, validCampaign())
+		assert.NoError(t, err)
+		assert.Equal(t, entity.StatusDraft, got.Status)
+		assert.Equal(t, 3, got.RecipientCount)
+		assert.Len(t, got.Rejections, 1)
+		assert.Equal(t, 2, got.Rejections[0].Index)
+		assert.Equal(t, "invalid", got.Rejections[0].Phone)
+		repo.AssertExpectations(t)
+		messages.AssertExpectations(t)
+	})
+
+	t.Run("no valid recipient", func(t *testing.T) {
+		repo := new(mockRepository)
+		messages := new(mockMessageService)
+		c := validCampaign()
+		c.Recipients = c.Recipients[2:]
+		repo.On("Create", ctx, mock.AnythingOfType("*campaign.Campaign")).Run(setID).Return(nil)
+		repo.On("Delete", ctx, tenant.DefaultID, id).Return(nil)
+		messages.On("CreateBatch", ctx, mock.Anything).Return([]message.CreateResult{{Err: message.ErrMessageDoesNotValidForCreate}}, nil)
+		svc := campaign.New(repo, messages, testConfig)
+		got, err := svc.Create(ctx, c)
+		assert.ErrorIs(t, err, entity.ErrCampaignDoesNotValidForCreate)
+		assert.Nil(t, got)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("message error", func(t *testing.T) {
+		repo := new(mockRepository)
+		messages := new(mockMessageService)
+		repo.On("Create", ctx, mock.AnythingOfType("*campaign.Campaign")).Run(setID).Return(nil)
+		repo.On("Delete", ctx, tenant.DefaultID, id).Return(nil)
+		messages.On("CreateBatch", ctx, mock.Anything).Return([]message.CreateResult(nil), errors.New("db error"))
+		svc := campaign.New(repo, messages, testConfig)
+		got, err := svc.Create(ctx, validCampaign())
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("releases the quota of created messages", func(t *testing.T) {
+		repo := new(mockRepository)
+		messages := new(mockMessageService)
+		repo.On("Create", ctx, mock.AnythingOfType("*campaign.Campaign")).Run(setID).Return(nil)
+		repo.On("Delete", ctx, tenant.DefaultID, id).Return(nil)
+		messages.On("CreateBatch", ctx, mock.MatchedBy(func(msgs []message.Message) bool {
+			return len(msgs) == 2
+		})).Return([]message.CreateResult{{Message: &message.Message{}}, {Err: message.ErrMessageDoesNotValidForCreate}}, nil)
+		messages.On("CreateBatch", ctx, mock.MatchedBy(func(msgs []message.Message) bool {
+			return len(msgs) == 1
+		})).Return([]message.CreateResult(nil), tenant.ErrTenantQuotaExceeded)
+		messages.On("ReleaseQuota", ctx, 1).Return(nil)
+		svc := campaign.New(repo, messages, testConfig)
+		got, err := svc.Create(ctx, validCampaign())
+		assert.ErrorIs(t, err, tenant.ErrTenantQuotaExceeded)
+		assert.Nil(t, got)
+		repo.AssertExpectations(t)
+		messages.AssertExpectations(t)
+	})
+
+	t.Run("invalid campaign", func(t *testing.T) {
+		repo := new(mockRepository)
+		messages := new(mockMessageService)
+		c := validCampaign()
+		c.Name = ""
+		svc := campaign.New(repo, messages, testConfig)
+		got, err := svc.Create(ctx, c)
+		assert.ErrorIs(t, err, entity.ErrCampaignDoesNotValidForCreate)
+		assert.Nil(t, got)
+	})
+}
+
+func TestService_Transitions(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+	id := uuid.New().String()
+
+	t.Run("start", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("FindByID", ctx, tenant.DefaultID, id).Return(&entity.Campaign{ID: id, TenantID: tenant.DefaultID, Status: entity.StatusDraft}, nil).Once()
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, id, entity.StatusDraft, entity.StatusRunning).Return(nil)
+		repo.On("FindByID", ctx, tenant.DefaultID, id).Return(&entity.Campaign{ID: id, TenantID: tenant.DefaultID, Status: entity.StatusRunning}, nil).Once()
+		repo.On("FindProgressByID", ctx, tenant.DefaultID, id).Return(entity.Progress{message.StatusPending: 2}, nil)
+		svc := campaign.New(repo, new(mockMessageService), testConfig)
+		got, err := svc.Start(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.StatusRunning, got.Status)
+		assert.Equal(t, 2, got.Progress.Total())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("FindByID", ctx, tenant.DefaultID, id).Return(&entity.Campaign{ID: id, TenantID: tenant.DefaultID, Status: entity.StatusPaused}, nil).Once()
+		repo.On("Cancel", ctx, tenant.DefaultID, id, entity.StatusPaused).Return(nil)
+		repo.On("FindByID", ctx, tenant.DefaultID, id).Return(&entity.Campaign{ID: id, TenantID: tenant.DefaultID, Status: entity.StatusCancelled}, nil).Once()
+		repo.On("FindProgressByID", ctx, tenant.DefaultID, id).Return(entity.Progress{message.StatusCancelled: 2}, nil)
+		svc := campaign.New(repo, new(mockMessageService), testConfig)
+		got, err := svc.Cancel(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.StatusCancelled, got.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("FindByID", ctx, tenant.DefaultID, id).Return(&entity.Campaign{ID: id, TenantID: tenant.DefaultID, Status: entity.StatusDraft}, nil)
+		svc := campaign.New(repo, new(mockMessageService), testConfig)
+		got, err := svc.Pause(ctx, id)
+		assert.ErrorIs(t, err, entity.ErrCampaignStatusTransitionNotAllowed)
+		assert.Nil(t, got)
+		repo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("changed in between", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("FindByID", ctx, tenant.DefaultID, id).Return(&entity.Campaign{ID: id, TenantID: tenant.DefaultID, Status: entity.StatusRunning}, nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, id, entity.StatusRunning, entity.StatusPaused).Return(postgresql.ErrNoRows)
+		svc := campaign.New(repo, new(mockMessageService), testConfig)
+		got, err := svc.Pause(ctx, id)
+		assert.ErrorIs(t, err, entity.ErrCampaignStatusTransitionNotAllowed)
+		assert.Nil(t, got)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("FindByID", ctx, tenant.DefaultID, id).Return((*entity.Campaign)(nil), postgresql.ErrNoRows)
+		svc := campaign.New(repo, new(mockMessageService), testConfig)
+		got, err := svc.Start(ctx, id)
+		assert.ErrorIs(t, err, entity.ErrCampaignNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("other tenant", func(t *testing.T) {
+		repo := new(mockRepository)
+		tenantID := uuid.New().String()
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: tenantID})
+		repo.On("FindByID", tenantCtx, tenantID, id).Return((*entity.Campaign)(nil), postgresql.ErrNoRows)
+		svc := campaign.New(repo, new(mockMessageService), testConfig)
+		got, err := svc.Start(tenantCtx, id)
+		assert.ErrorIs(t, err, entity.ErrCampaignNotFound)
+		assert.Nil(t, got)
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,59 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Start(ctx context.Context, id string) (*campaign.Campaign, error) {
+	return s.transition(ctx, id, campaign.StatusRunning)
+}
+
+func (s *service) Pause(ctx context.Context, id string) (*campaign.Campaign, error) {
+	return s.transition(ctx, id, campaign.StatusPaused)
+}
+
+// Cancel stops the campaign for good and cancels its messages that are not
+// being sent yet.
+func (s *service) Cancel(ctx context.Context, id string) (*campaign.Campaign, error) {
+	return s.transition(ctx, id, campaign.StatusCancelled)
+}
+
+func (s *service) transition(ctx context.Context, id string, to campaign.Status) (*campaign.Campaign, error) {
+	foundCampaign, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := foundCampaign.Status
+
+	if err := foundCampaign.TransitionTo(to); err != nil {
+		return nil, err
+	}
+
+	if to == campaign.StatusCancelled {
+		if err = s.repository.Cancel(ctx, foundCampaign.TenantID, id, from); err != nil {
+			err = fmt.Errorf("service.repository.Cancel(): %w", err)
+		}
+	} else {
+		if err = s.repository.UpdateStatus(ctx, foundCampaign.TenantID, id, from, to); err != nil {
+			err = fmt.Errorf("service.repository.UpdateStatus(): %w", err)
+		}
+	}
+
+	// The campaign changed status in between, which makes this transition stale.
+	if errors.Is(err, postgresql.ErrNoRows) {
+		foundCampaign.Status = from
+
+		return nil, foundCampaign.NewErrCampaignStatusTransitionNotAllowed(to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
@@ -0,0 +1,35 @@
+package consent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/consent"
+	"messager/domain/tenant"
+)
+
+func (s *service) Create(ctx context.Context, consent consent.Consent) (*consent.Consent, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	region := current.Region(s.config.DefaultRegion)
+
+	if err := consent.ValidateForCreate(region); err != nil {
+		return nil, errors.Join(consent.NewErrConsentDoesNotValidForCreate(), err)
+	}
+
+	if err := consent.NormalizeForCreate(region); err != nil {
+		return nil, errors.Join(consent.NewErrConsentDoesNotValidForCreate(), err)
+	}
+
+	consent.TenantID = current.ID
+
+	if err := s.repository.Create(ctx, &consent); err != nil {
+		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+	}
+
+	return &consent, nil
+}
@@ -0,0 +1,38 @@
+package consent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/consent"
+	"messager/domain/tenant"
+)
+
+func (s *service) ListByPhone(ctx context.Context, phone string) ([]consent.Consent, error) {
+	consent := consent.Consent{
+		Phone: phone,
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	region := current.Region(s.config.DefaultRegion)
+
+	if err := consent.ValidateForListByPhone(region); err != nil {
+		return nil, errors.Join(consent.NewErrConsentDoesNotValidForListByPhone(), err)
+	}
+
+	if err := consent.NormalizePhone(region); err != nil {
+		return nil, errors.Join(consent.NewErrConsentDoesNotValidForListByPhone(), err)
+	}
+
+	consents, err := s.repository.FindAllByPhone(ctx, current.ID, consent.Phone)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAllByPhone(): %w", err)
+	}
+
+	return consents, nil
+}
@@ -0,0 +1,21 @@
+package consent
+
+import (
+	"messager/domain/consent"
+)
+
+type Config struct {
+	DefaultRegion string
+}
+
+type service struct {
+	repository consent.Repository
+	config     *Config
+}
+
+func New(repository consent.Repository, config Config) consent.Service {
+	return &service{
+		repository: repository,
+		config:     &config,
+	}
+}
@@ -0,0 +1,49 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"messager/domain/message"
+	entity "messager/domain/message"
+)
+
+// findOptedOut reports for every message of a tenant whether its recipient
+// opted out of its category with the tenant. Categories that do not require
+// consent are not looked up.
+func (s *service) findOptedOut(ctx context.Context, tenantID string, messages []*message.Message) ([]bool, error) {
+	phones := make(map[message.Category]map[string]bool)
+
+	for _, message := range messages {
+		if !message.Category.RequiresConsent() || message.Channel == entity.ChannelEmail {
+			continue
+		}
+
+		if phones[message.Category] == nil {
+			phones[message.Category] = make(map[string]bool)
+		}
+
+		phones[message.Category][message.Phone] = false
+	}
+
+	for category, categoryPhones := range phones {
+		optedOutPhones, err := s.consentRepository.FindAllOptedOutPhones(ctx, tenantID, slices.Collect(maps.Keys(categoryPhones)), category)
+		if err != nil {
+			return nil, fmt.Errorf("service.consentRepository.FindAllOptedOutPhones(): %w", err)
+		}
+
+		for _, phone := range optedOutPhones {
+			categoryPhones[phone] = true
+		}
+	}
+
+	optedOut := make([]bool, len(messages))
+
+	for i, message := range messages {
+		optedOut[i] = phones[message.Category][message.Phone]
+	}
+
+	return optedOut, nil
+}
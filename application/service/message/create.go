@@ -5,17 +5,111 @@ import (
 	"errors"
 	"fmt"
 
+	"messager/domain/fault"
 	"messager/domain/message"
+	entity "messager/domain/message"
+	"messager/domain/policy"
+	"messager/domain/sender"
+	"messager/domain/template"
+	"messager/domain/tenant"
 )
 
 func (s *service) Create(ctx context.Context, message message.Message) (*message.Message, error) {
-	if err := message.ValidateForCreate(); err != nil {
-		return nil, errors.Join(message.NewErrMessageDoesNotValidForCreate(), err)
+	if message.IdempotencyKey != "" {
+		return s.createIdempotent(ctx, message)
+	}
+
+	return s.create(ctx, message)
+}
+
+func (s *service) create(ctx context.Context, message message.Message) (*message.Message, error) {
+	engine, err := s.newPolicyEngine(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	senders, err := s.findSenders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.prepare(ctx, &message, nil, engine, senders); err != nil {
+		return nil, err
+	}
+
+	optedOut, err := s.findOptedOut(ctx, message.TenantID, []*entity.Message{&message})
+	if err != nil {
+		return nil, err
+	}
+
+	if optedOut[0] {
+		return nil, message.NewErrMessageRecipientOptedOut()
+	}
+
+	if err := s.reserveQuota(ctx, 1); err != nil {
+		return nil, err
 	}
 
 	if err := s.repository.Create(ctx, &message); err != nil {
-		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+		err = fmt.Errorf("service.repository.Create(): %w", err)
+
+		if releaseErr := s.releaseQuota(ctx, 1); releaseErr != nil {
+			return nil, errors.Join(err, releaseErr)
+		}
+
+		return nil, err
 	}
 
 	return &message, nil
 }
+
+// prepare renders, validates, checks against the content policies, normalizes
+// and assigns a sender to a message of the tenant of ctx before it is
+// persisted. Templates found while rendering are cached in templates when it
+// is not nil.
+func (s *service) prepare(
+	ctx context.Context,
+	message *message.Message,
+	templates map[string]*template.Template,
+	engine *policy.Engine,
+	senders []sender.Sender,
+) error {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	options := s.createOptions(current)
+	message.TenantID = current.ID
+
+	if message.TemplateID != "" {
+		if err := s.render(ctx, message, templates, options.DefaultRegion); err != nil {
+			return err
+		}
+	}
+
+	if err := message.ValidateForCreate(options); err != nil {
+		return errors.Join(message.NewErrMessageDoesNotValidForCreate(), err)
+	}
+
+	if violations := engine.Evaluate(message.Content, message.Subject, message.HTMLContent); len(violations) > 0 {
+		return errors.Join(message.NewErrMessageDoesNotValidForCreate(), &policy.ViolationError{Violations: violations})
+	}
+
+	if err := message.NormalizeForCreate(options); err != nil {
+		return errors.Join(message.NewErrMessageDoesNotValidForCreate(), err)
+	}
+
+	if _, ok := s.clients[message.Channel]; !ok {
+		return errors.Join(message.NewErrMessageDoesNotValidForCreate(), fault.NewFieldError("channel", fault.CodeNotAllowed,
+			fmt.Sprintf("message channel %s is not configured", message.Channel), nil))
+	}
+
+	if message.Channel == entity.ChannelSMS {
+		if err := s.assignSender(message, senders, options.DefaultRegion); err != nil {
+			return errors.Join(message.NewErrMessageDoesNotValidForCreate(), err)
+		}
+	}
+
+	return nil
+}
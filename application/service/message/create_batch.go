@@ -0,0 +1,94 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"messager/domain/message"
+	"messager/domain/template"
+	"messager/domain/tenant"
+)
+
+// CreateBatch creates the valid messages of the batch in a single round trip.
+// Invalid messages are reported in their result instead of failing the batch.
+func (s *service) CreateBatch(ctx context.Context, messages []message.Message) ([]message.CreateResult, error) {
+	if err := message.ValidateForCreateBatch(messages, s.config.MaxBatchSize); err != nil {
+		return nil, errors.Join(message.ErrMessageDoesNotValidForCreateBatch, err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	messages = slices.Clone(messages)
+	results := make([]message.CreateResult, len(messages))
+	preparedMessages := make([]*message.Message, 0, len(messages))
+	preparedIndexes := make([]int, 0, len(messages))
+	templates := make(map[string]*template.Template)
+
+	engine, err := s.newPolicyEngine(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	senders, err := s.findSenders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range messages {
+		if err := s.prepare(ctx, &messages[i], templates, engine, senders); err != nil {
+			if !errors.Is(err, message.ErrMessageDoesNotValidForCreate) {
+				return nil, err
+			}
+
+			results[i].Err = err
+
+			continue
+		}
+
+		preparedMessages = append(preparedMessages, &messages[i])
+		preparedIndexes = append(preparedIndexes, i)
+	}
+
+	optedOut, err := s.findOptedOut(ctx, current.ID, preparedMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	validMessages := make([]*message.Message, 0, len(preparedMessages))
+
+	for j, i := range preparedIndexes {
+		if optedOut[j] {
+			results[i].Err = messages[i].NewErrMessageRecipientOptedOut()
+
+			continue
+		}
+
+		results[i].Message = &messages[i]
+		validMessages = append(validMessages, &messages[i])
+	}
+
+	if len(validMessages) == 0 {
+		return results, nil
+	}
+
+	if err := s.reserveQuota(ctx, len(validMessages)); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.CreateAll(ctx, validMessages); err != nil {
+		err = fmt.Errorf("service.repository.CreateAll(): %w", err)
+
+		if releaseErr := s.releaseQuota(ctx, len(validMessages)); releaseErr != nil {
+			return nil, errors.Join(err, releaseErr)
+		}
+
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,101 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+	entity "messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// idempotencyLockTTL bounds how long a key stays reserved when the replica
+// creating its message dies before completing it.
+const idempotencyLockTTL = time.Minute
+
+// idempotencyCompleteAttempts is how many times completing a key is tried
+// before the created message is returned without it.
+const idempotencyCompleteAttempts = 3
+
+// createIdempotent creates the message once per idempotency key. Retries with
+// the same request return the message created first, retries with a
+// different request are rejected.
+func (s *service) createIdempotent(ctx context.Context, message message.Message) (*message.Message, error) {
+	if err := message.ValidateForIdempotency(); err != nil {
+		return nil, errors.Join(message.NewErrMessageDoesNotValidForCreate(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	record := entity.IdempotencyRecord{
+		TenantID:    current.ID,
+		Key:         message.IdempotencyKey,
+		Fingerprint: message.Fingerprint(),
+	}
+
+	storedRecord, err := s.repository.ReserveIdempotencyKey(ctx, record, idempotencyLockTTL)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.ReserveIdempotencyKey(): %w", err)
+	}
+
+	if storedRecord != nil {
+		return s.replay(ctx, message, record, storedRecord)
+	}
+
+	newMessage, err := s.create(ctx, message)
+	if err != nil {
+		if releaseErr := s.repository.ReleaseIdempotencyKey(ctx, record.TenantID, record.Key); releaseErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("service.repository.ReleaseIdempotencyKey(): %w", releaseErr))
+		}
+
+		return nil, err
+	}
+
+	record.MessageID = newMessage.ID
+
+	// The message is created at this point, so failing the request would make
+	// the client retry and create it again once the reservation expires.
+	if err := s.completeIdempotencyKey(ctx, record); err != nil {
+		s.reportError(err)
+	}
+
+	return newMessage, nil
+}
+
+func (s *service) completeIdempotencyKey(ctx context.Context, record message.IdempotencyRecord) error {
+	var err error
+
+	for range idempotencyCompleteAttempts {
+		if err = s.repository.CompleteIdempotencyKey(ctx, record, s.config.IdempotencyTTL); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("service.repository.CompleteIdempotencyKey(): %w", err)
+}
+
+func (s *service) replay(ctx context.Context, message message.Message, requested message.IdempotencyRecord, record *message.IdempotencyRecord) (*message.Message, error) {
+	if record.Fingerprint != requested.Fingerprint {
+		return nil, message.NewErrMessageIdempotencyKeyReused()
+	}
+
+	if !record.IsCompleted() {
+		return nil, message.NewErrMessageIdempotencyKeyInProgress()
+	}
+
+	foundMessage, err := s.repository.FindByID(ctx, requested.TenantID, record.MessageID)
+	if foundMessage == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return nil, message.NewErrMessageNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindByID(): %w", err)
+	}
+
+	return foundMessage, nil
+}
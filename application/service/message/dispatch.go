@@ -0,0 +1,39 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// Dispatch sends the messages claimed by the entries, in their order, and
+// skips the other status changes. A message that is still SENDING after a
+// failed dispatch is returned to PENDING, so that it is claimed again instead
+// of waiting for the claim timeout.
+func (s *service) Dispatch(ctx context.Context, entries []message.OutboxEntry) error {
+	var errs []error
+
+	for _, entry := range entries {
+		if !entry.IsDispatch() {
+			continue
+		}
+
+		// Sent only finds messages of the tenant of its context.
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: entry.TenantID})
+
+		if err := s.Sent(tenantCtx, message.Message{ID: entry.MessageID}); err != nil {
+			errs = append(errs, fmt.Errorf("service.Sent(%s): %w", entry.MessageID, err))
+
+			err = s.repository.UpdateStatus(tenantCtx, entry.TenantID, entry.MessageID, message.StatusSending, message.StatusPending)
+			if err != nil && !errors.Is(err, postgresql.ErrNoRows) {
+				errs = append(errs, fmt.Errorf("service.repository.UpdateStatus(%s): %w", entry.MessageID, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
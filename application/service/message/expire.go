@@ -0,0 +1,18 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/message"
+)
+
+func (s *service) Expire(ctx context.Context) error {
+	for _, status := range []message.Status{message.StatusPending, message.StatusQueued} {
+		if err := s.repository.ExpireAllByStatus(ctx, status); err != nil {
+			return fmt.Errorf("service.repository.ExpireAllByStatus(): %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Get(ctx context.Context, id string) (*message.Message, error) {
+	message := message.Message{
+		ID: id,
+	}
+
+	if err := message.ValidateForGet(); err != nil {
+		return nil, errors.Join(message.NewErrMessageDoesNotValidForGet(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	foundMessage, err := s.repository.FindByID(ctx, current.ID, id)
+	if foundMessage == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return nil, message.NewErrMessageNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindByID(): %w", err)
+	}
+
+	attempts, err := s.repository.FindAllAttemptsByMessageID(ctx, current.ID, id)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAllAttemptsByMessageID(): %w", err)
+	}
+
+	foundMessage.Attempts = attempts
+
+	return foundMessage, nil
+}
@@ -6,21 +6,29 @@ import (
 	"fmt"
 
 	"messager/domain/message"
+	"messager/domain/tenant"
 )
 
-func (s *service) ListByStatus(ctx context.Context, status message.Status) ([]message.Message, error) {
-	message := message.Message{
-		Status: status,
+func (s *service) ListByStatus(ctx context.Context, filter message.ListFilter) (*message.Page, error) {
+	message := message.Message{}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
 	}
 
-	if err := message.ValidateForListByStatus(); err != nil {
+	filter.Normalize(s.createOptions(current).DefaultRegion)
+
+	if err := filter.Validate(); err != nil {
 		return nil, errors.Join(message.NewErrMessageDoesNotValidForListByStatus(), err)
 	}
 
-	messages, err := s.repository.FindAllByStatus(ctx, status)
+	filter.TenantID = current.ID
+
+	page, err := s.repository.FindAllByStatus(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("service.repository.FindAllByStatus(): %w", err)
 	}
 
-	return messages, nil
+	return page, nil
 }
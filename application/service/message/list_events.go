@@ -0,0 +1,41 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) ListEvents(ctx context.Context, id string) ([]message.Event, error) {
+	message := message.Message{
+		ID: id,
+	}
+
+	if err := message.ValidateForListEvents(); err != nil {
+		return nil, errors.Join(message.NewErrMessageDoesNotValidForListEvents(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	foundMessage, err := s.repository.FindByID(ctx, current.ID, id)
+	if foundMessage == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return nil, message.NewErrMessageNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindByID(): %w", err)
+	}
+
+	events, err := s.repository.FindAllEventsByMessageID(ctx, current.ID, id)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAllEventsByMessageID(): %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,31 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"messager/domain/policy"
+	"messager/domain/tenant"
+)
+
+// newPolicyEngine builds the content policy engine from the configured
+// policies and the ones the tenant of ctx stored in the database.
+func (s *service) newPolicyEngine(ctx context.Context) (*policy.Engine, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	policies, err := s.policyRepository.FindAll(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service.policyRepository.FindAll(): %w", err)
+	}
+
+	engine, err := policy.NewEngine(slices.Concat(s.config.ContentPolicies, policies)...)
+	if err != nil {
+		return nil, fmt.Errorf("policy.NewEngine(): %w", err)
+	}
+
+	return engine, nil
+}
@@ -3,13 +3,38 @@ package message
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"messager/domain/message"
 )
 
+// Process requeues the claims that were not sent within the claim timeout,
+// then claims a batch of due messages of every priority for sending. Queued
+// messages, which were held back by quiet hours, are claimed before pending
+// ones since they were due first.
 func (s *service) Process(ctx context.Context) error {
-	if err := s.repository.UpdateAllStatusesByStatus(ctx, message.StatusPending, message.StatusSent); err != nil {
-		return fmt.Errorf("service.repository.UpdateAllStatusesByStatus(): %w", err)
+	if s.config.ClaimTimeout > 0 {
+		if err := s.repository.RequeueAllByStatus(ctx, message.StatusSending, time.Now().Add(-s.config.ClaimTimeout)); err != nil {
+			return fmt.Errorf("service.repository.RequeueAllByStatus(): %w", err)
+		}
+	}
+
+	for _, priority := range message.Priorities {
+		limit := s.config.BatchSizes[priority]
+		if limit <= 0 {
+			continue
+		}
+
+		for _, status := range []message.Status{message.StatusQueued, message.StatusPending} {
+			claimed, err := s.repository.ClaimAllByStatusAndPriority(ctx, status, message.StatusSending, priority, limit)
+			if err != nil {
+				return fmt.Errorf("service.repository.ClaimAllByStatusAndPriority(): %w", err)
+			}
+
+			if limit -= len(claimed); limit <= 0 {
+				break
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,36 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/message"
+)
+
+// Relay relays a batch of the outbox to the publisher, or dispatches it in
+// process without one. Entries are relayed again when publishing fails.
+// Dispatching runs after the batch is relayed, so that the outbox is not
+// locked while the clients are called; a failed dispatch is returned to
+// PENDING by Dispatch to be claimed again.
+func (s *service) Relay(ctx context.Context) error {
+	var relayed []message.OutboxEntry
+
+	err := s.repository.RelayOutbox(ctx, s.config.OutboxBatchSize, func(entries []message.OutboxEntry) error {
+		if s.config.Publisher != nil {
+			if err := s.config.Publisher.Publish(ctx, entries); err != nil {
+				return fmt.Errorf("service.publisher.Publish(): %w", err)
+			}
+
+			return nil
+		}
+
+		relayed = entries
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("service.repository.RelayOutbox(): %w", err)
+	}
+
+	return s.Dispatch(ctx, relayed)
+}
@@ -0,0 +1,9 @@
+package message
+
+import "context"
+
+// ReleaseQuota gives back the quota reserved for count messages of the tenant
+// of ctx that were created and then deleted before being sent.
+func (s *service) ReleaseQuota(ctx context.Context, count int) error {
+	return s.releaseQuota(ctx, count)
+}
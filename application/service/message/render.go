@@ -0,0 +1,63 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/message"
+	"messager/domain/template"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// render fills the content of a message created from a template with the
+// variant selected for its locale or phone region. National phone numbers are
+// parsed in defaultRegion.
+func (s *service) render(ctx context.Context, message *message.Message, templates map[string]*template.Template, defaultRegion string) error {
+	if err := message.ValidateForRender(); err != nil {
+		return errors.Join(message.NewErrMessageDoesNotValidForCreate(), err)
+	}
+
+	foundTemplate, err := s.findTemplate(ctx, message.TemplateID, templates)
+	if foundTemplate == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return errors.Join(message.NewErrMessageDoesNotValidForCreate(), template.ErrTemplateNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("service.templateRepository.FindByID(): %w", err)
+	}
+
+	variant := foundTemplate.SelectVariant(message.Locale, message.PhoneRegion(defaultRegion))
+
+	content, err := variant.Render(message.Variables)
+	if err != nil {
+		return errors.Join(message.NewErrMessageDoesNotValidForCreate(), err)
+	}
+
+	message.Content = content
+	message.Locale = variant.Locale
+
+	return nil
+}
+
+func (s *service) findTemplate(ctx context.Context, id string, templates map[string]*template.Template) (*template.Template, error) {
+	if foundTemplate, ok := templates[id]; ok {
+		return foundTemplate, nil
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	foundTemplate, err := s.templateRepository.FindByID(ctx, current.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if templates != nil {
+		templates[id] = foundTemplate
+	}
+
+	return foundTemplate, nil
+}
@@ -0,0 +1,58 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/fault"
+	"messager/domain/message"
+	"messager/domain/sender"
+	"messager/domain/tenant"
+)
+
+// findSenders returns the pool SMS messages of the tenant of ctx are assigned
+// a sender from.
+func (s *service) findSenders(ctx context.Context) ([]sender.Sender, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	senders, err := s.senderRepository.FindAll(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service.senderRepository.FindAll(): %w", err)
+	}
+
+	return senders, nil
+}
+
+// assignSender checks the requested sender of a message against the pool, or
+// selects one with the configured strategy when none is requested. Messages no
+// sender of the pool is allowed for are sent under the provider's default.
+func (s *service) assignSender(m *message.Message, senders []sender.Sender, defaultRegion string) error {
+	if m.Sender == "" {
+		if selected, ok := s.selector.Select(senders, m.Phone, m.Region); ok {
+			m.Sender = selected.Value
+		}
+
+		return nil
+	}
+
+	for _, candidate := range senders {
+		if !candidate.Matches(m.Sender, defaultRegion) {
+			continue
+		}
+
+		if !candidate.Allows(m.Region) {
+			return fault.NewFieldError("from", fault.CodeNotAllowed,
+				fmt.Sprintf("message from must be allowed for the recipient's country %s", m.Region),
+				map[string]any{"countries": candidate.Countries})
+		}
+
+		m.Sender = candidate.Value
+
+		return nil
+	}
+
+	return fault.NewFieldError("from", fault.CodeNotOneOf, "message from must be a sender of the pool", nil)
+}
@@ -8,6 +8,8 @@ import (
 
 	"messager/domain/message"
 	entity "messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/client"
 	"messager/infrastructure/database/postgresql"
 )
 
@@ -16,7 +18,12 @@ func (s *service) Sent(ctx context.Context, message message.Message) error {
 		return errors.Join(message.NewErrMessageDoesNotValidForSent(), err)
 	}
 
-	foundMessage, err := s.repository.FindByID(ctx, message.ID)
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	foundMessage, err := s.repository.FindByID(ctx, current.ID, message.ID)
 	if foundMessage == nil || errors.Is(err, postgresql.ErrNoRows) {
 		return message.NewErrMessageNotFound()
 	}
@@ -24,17 +31,94 @@ func (s *service) Sent(ctx context.Context, message message.Message) error {
 		return fmt.Errorf("service.repository.FindByID(): %w", err)
 	}
 
-	if foundMessage.Status != entity.StatusSent {
+	if foundMessage.Status != entity.StatusSending {
 		return message.NewErrMessageStatusDoesNotEligibleForSent()
 	}
 
-	id, err := s.client.SendMessage(ctx, *foundMessage)
+	if foundMessage.IsExpired(time.Now()) {
+		if err := s.transition(ctx, foundMessage, entity.StatusExpired); err != nil {
+			return errors.Join(message.NewErrMessageExpired(), err)
+		}
+
+		return message.NewErrMessageExpired()
+	}
+
+	optedOut, err := s.findOptedOut(ctx, foundMessage.TenantID, []*entity.Message{foundMessage})
+	if err != nil {
+		return err
+	}
+
+	if optedOut[0] {
+		if err := s.transition(ctx, foundMessage, entity.StatusCancelled); err != nil {
+			return errors.Join(message.NewErrMessageRecipientOptedOut(), err)
+		}
+
+		return message.NewErrMessageRecipientOptedOut()
+	}
+
+	if quietUntil := foundMessage.QuietUntil(time.Now(), s.config.QuietHours); !quietUntil.IsZero() {
+		return s.reschedule(ctx, foundMessage, quietUntil)
+	}
+
+	sender, ok := s.clients[foundMessage.Channel]
+	if !ok {
+		if err := s.transition(ctx, foundMessage, entity.StatusFailed); err != nil {
+			return errors.Join(message.NewErrMessageChannelNotSupported(), err)
+		}
+
+		return message.NewErrMessageChannelNotSupported()
+	}
+
+	startedAt := time.Now()
+	response, err := sender.SendMessage(ctx, *foundMessage)
+
+	attempt := entity.Attempt{
+		MessageID:         foundMessage.ID,
+		Provider:          sender.Provider(),
+		ProviderMessageID: response.ID,
+		Status:            entity.AttemptStatusSucceeded,
+		ResponseStatus:    response.Status,
+		Latency:           time.Since(startedAt),
+	}
+
 	if err != nil {
-		return fmt.Errorf("service.client.SendMessage(): %w", err)
+		err = fmt.Errorf("service.client.SendMessage(): %w", err)
+
+		status := entity.StatusFailed
+		attempt.Status = entity.AttemptStatusFailed
+		attempt.ErrorClass = entity.ErrorClassPermanent
+
+		if errors.Is(err, client.ErrTemporary) {
+			status = entity.StatusPending
+			attempt.ErrorClass = entity.ErrorClassTemporary
+		}
+
+		if attemptErr := s.repository.CreateAttempt(ctx, &attempt); attemptErr != nil {
+			err = errors.Join(err, fmt.Errorf("service.repository.CreateAttempt(): %w", attemptErr))
+		}
+
+		if transitionErr := s.transition(ctx, foundMessage, status); transitionErr != nil {
+			return errors.Join(err, transitionErr)
+		}
+
+		return err
+	}
+
+	// The attempt is recorded before the transition, so that a sent message
+	// has the attempt that sent it. The message is moved to SENT even when
+	// recording fails, since it must not be sent again.
+	if err := s.repository.CreateAttempt(ctx, &attempt); err != nil {
+		err = fmt.Errorf("service.repository.CreateAttempt(): %w", err)
+
+		if transitionErr := s.transition(ctx, foundMessage, entity.StatusSent); transitionErr != nil {
+			return errors.Join(err, transitionErr)
+		}
+
+		return err
 	}
 
-	if err := s.repository.CreateSentInfo(ctx, id, time.Now().Format(time.RFC3339)); err != nil {
-		return fmt.Errorf("service.repository.CreateSentInfo(): %w", err)
+	if err := s.transition(ctx, foundMessage, entity.StatusSent); err != nil {
+		return err
 	}
 
 	return nil
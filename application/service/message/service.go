@@ -1,18 +1,70 @@
 package message
 
 import (
+	"time"
+
+	"messager/domain/consent"
 	"messager/domain/message"
+	"messager/domain/policy"
+	"messager/domain/sender"
+	"messager/domain/template"
 	"messager/infrastructure/client"
 )
 
+type Config struct {
+	BatchSizes      map[message.Priority]int
+	CreateOptions   message.CreateOptions
+	IdempotencyTTL  time.Duration
+	MaxBatchSize    int
+	QuietHours      map[message.Category]message.QuietHours
+	ContentPolicies []policy.Policy
+	SenderStrategy  sender.Strategy
+	// Publisher publishes the outbox, which is dispatched in process when nil.
+	Publisher       message.Publisher
+	OutboxBatchSize int
+	// ClaimTimeout is how long a message may stay in SENDING before it is
+	// claimed again, since the dispatch of a claim can be lost. It is not
+	// limited when zero.
+	ClaimTimeout time.Duration
+	// OnError is called with the errors that do not fail the operation they
+	// happen in.
+	OnError func(err error)
+}
+
 type service struct {
-	repository message.Repository
-	client     client.Client
+	repository         message.Repository
+	templateRepository template.Repository
+	consentRepository  consent.Repository
+	policyRepository   policy.Repository
+	senderRepository   sender.Repository
+	selector           *sender.Selector
+	clients            map[message.Channel]client.Client
+	config             *Config
 }
 
-func New(repository message.Repository, client client.Client) message.Service {
+func New(
+	repository message.Repository,
+	templateRepository template.Repository,
+	consentRepository consent.Repository,
+	policyRepository policy.Repository,
+	senderRepository sender.Repository,
+	clients map[message.Channel]client.Client,
+	config Config,
+) message.Service {
 	return &service{
-		repository: repository,
-		client:     client,
+		repository:         repository,
+		templateRepository: templateRepository,
+		consentRepository:  consentRepository,
+		policyRepository:   policyRepository,
+		senderRepository:   senderRepository,
+		selector:           sender.NewSelector(config.SenderStrategy),
+		clients:            clients,
+		config:             &config,
+	}
+}
+
+func (s *service) reportError(err error) {
+	if s.config.OnError != nil {
+		s.config.OnError(err)
 	}
 }
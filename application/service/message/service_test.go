@@ -4,132 +4,1082 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"messager/application/service/message"
+	"messager/domain/consent"
+	"messager/domain/fault"
 	entity "messager/domain/message"
+	"messager/domain/policy"
+	"messager/domain/sender"
+	"messager/domain/template"
+	"messager/domain/tenant"
+	"messager/infrastructure/client"
 	"messager/infrastructure/database/postgresql"
+	"messager/infrastructure/database/redis"
+	"messager/infrastructure/persistence/memory"
 )
 
+var testConfig = message.Config{
+	BatchSizes: map[entity.Priority]int{
+		entity.PriorityTransactional: 100,
+		entity.PriorityNormal:        50,
+		entity.PriorityBulk:          20,
+	},
+	CreateOptions: entity.CreateOptions{
+		MaxSegments:   4,
+		DefaultRegion: "TR",
+	},
+	IdempotencyTTL:  24 * time.Hour,
+	MaxBatchSize:    3,
+	OutboxBatchSize: 100,
+}
+
 type mockRepository struct {
 	mock.Mock
 }
 
-func (m *mockRepository) Create(ctx context.Context, msg *entity.Message) error {
-	args := m.Called(ctx, msg)
-	return args.Error(0)
-}
+func (m *mockRepository) Create(ctx context.Context, msg *entity.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+func (m *mockRepository) CreateAll(ctx context.Context, msgs []*entity.Message) error {
+	args := m.Called(ctx, msgs)
+	return args.Error(0)
+}
+
+func (m *mockRepository) FindAllByStatus(ctx context.Context, filter entity.ListFilter) (*entity.Page, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(*entity.Page), args.Error(1)
+}
+
+func (m *mockRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.Message, error) {
+	args := m.Called(ctx, tenantID, id)
+	return args.Get(0).(*entity.Message), args.Error(1)
+}
+
+func (m *mockRepository) ClaimAllByStatusAndPriority(ctx context.Context, from, to entity.Status, priority entity.Priority, limit int) ([]entity.Message, error) {
+	args := m.Called(ctx, from, to, priority, limit)
+	return args.Get(0).([]entity.Message), args.Error(1)
+}
+
+func (m *mockRepository) UpdateStatus(ctx context.Context, tenantID, id string, from, to entity.Status) error {
+	args := m.Called(ctx, tenantID, id, from, to)
+	return args.Error(0)
+}
+
+func (m *mockRepository) Reschedule(ctx context.Context, tenantID, id string, from entity.Status, sendAt time.Time) error {
+	args := m.Called(ctx, tenantID, id, from, sendAt)
+	return args.Error(0)
+}
+
+func (m *mockRepository) CreateAttempt(ctx context.Context, attempt *entity.Attempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func (m *mockRepository) FindAllAttemptsByMessageID(ctx context.Context, tenantID, messageID string) ([]entity.Attempt, error) {
+	args := m.Called(ctx, tenantID, messageID)
+	return args.Get(0).([]entity.Attempt), args.Error(1)
+}
+
+func (m *mockRepository) ExpireAllByStatus(ctx context.Context, status entity.Status) error {
+	args := m.Called(ctx, status)
+	return args.Error(0)
+}
+
+func (m *mockRepository) RequeueAllByStatus(ctx context.Context, status entity.Status, updatedBefore time.Time) error {
+	args := m.Called(ctx, status, updatedBefore)
+	return args.Error(0)
+}
+
+func (m *mockRepository) FindAllEventsByMessageID(ctx context.Context, tenantID, messageID string) ([]entity.Event, error) {
+	args := m.Called(ctx, tenantID, messageID)
+	return args.Get(0).([]entity.Event), args.Error(1)
+}
+
+func (m *mockRepository) ReserveIdempotencyKey(ctx context.Context, record entity.IdempotencyRecord, ttl time.Duration) (*entity.IdempotencyRecord, error) {
+	args := m.Called(ctx, record, ttl)
+	return args.Get(0).(*entity.IdempotencyRecord), args.Error(1)
+}
+
+func (m *mockRepository) CompleteIdempotencyKey(ctx context.Context, record entity.IdempotencyRecord, ttl time.Duration) error {
+	args := m.Called(ctx, record, ttl)
+	return args.Error(0)
+}
+
+func (m *mockRepository) ReleaseIdempotencyKey(ctx context.Context, tenantID, key string) error {
+	args := m.Called(ctx, tenantID, key)
+	return args.Error(0)
+}
+
+func (m *mockRepository) ReserveQuota(ctx context.Context, tenantID string, day time.Time, count, limit int) (bool, error) {
+	args := m.Called(ctx, tenantID, day, count, limit)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockRepository) ReleaseQuota(ctx context.Context, tenantID string, day time.Time, count int) error {
+	args := m.Called(ctx, tenantID, day, count)
+	return args.Error(0)
+}
+
+// RelayOutbox hands the entries of the first return value to relay, as the
+// persistence does within its transaction.
+func (m *mockRepository) RelayOutbox(ctx context.Context, limit int, relay func(entries []entity.OutboxEntry) error) error {
+	args := m.Called(ctx, limit)
+	if entries, ok := args.Get(0).([]entity.OutboxEntry); ok {
+		if err := relay(entries); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+type mockPublisher struct {
+	mock.Mock
+}
+
+func (m *mockPublisher) Publish(ctx context.Context, entries []entity.OutboxEntry) error {
+	args := m.Called(ctx, entries)
+	return args.Error(0)
+}
+
+func (m *mockPublisher) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+type mockTemplateRepository struct {
+	mock.Mock
+}
+
+func (m *mockTemplateRepository) Create(ctx context.Context, tmpl *template.Template) error {
+	args := m.Called(ctx, tmpl)
+	return args.Error(0)
+}
+
+func (m *mockTemplateRepository) FindAll(ctx context.Context, tenantID string) ([]template.Template, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]template.Template), args.Error(1)
+}
+
+func (m *mockTemplateRepository) FindByID(ctx context.Context, tenantID, id string) (*template.Template, error) {
+	args := m.Called(ctx, tenantID, id)
+	return args.Get(0).(*template.Template), args.Error(1)
+}
+
+func (m *mockTemplateRepository) Update(ctx context.Context, tmpl *template.Template) error {
+	args := m.Called(ctx, tmpl)
+	return args.Error(0)
+}
+
+func (m *mockTemplateRepository) Delete(ctx context.Context, tenantID, id string) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+type mockConsentRepository struct {
+	mock.Mock
+}
+
+func (m *mockConsentRepository) Create(ctx context.Context, c *consent.Consent) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *mockConsentRepository) FindAllByPhone(ctx context.Context, tenantID, phone string) ([]consent.Consent, error) {
+	args := m.Called(ctx, tenantID, phone)
+	return args.Get(0).([]consent.Consent), args.Error(1)
+}
+
+func (m *mockConsentRepository) FindAllOptedOutPhones(ctx context.Context, tenantID string, phones []string, category entity.Category) ([]string, error) {
+	args := m.Called(ctx, tenantID, phones, category)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func newConsentRepository(optedOut ...string) *mockConsentRepository {
+	consents := new(mockConsentRepository)
+	consents.On("FindAllOptedOutPhones", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(optedOut, nil).Maybe()
+	return consents
+}
+
+type mockPolicyRepository struct {
+	mock.Mock
+}
+
+func (m *mockPolicyRepository) Create(ctx context.Context, p *policy.Policy) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+func (m *mockPolicyRepository) FindAll(ctx context.Context, tenantID string) ([]policy.Policy, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]policy.Policy), args.Error(1)
+}
+
+func (m *mockPolicyRepository) Delete(ctx context.Context, tenantID, id string) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+func newPolicyRepository(policies ...policy.Policy) *mockPolicyRepository {
+	policyRepository := new(mockPolicyRepository)
+	policyRepository.On("FindAll", mock.Anything, mock.Anything).Return(policies, nil).Maybe()
+	return policyRepository
+}
+
+type mockSenderRepository struct {
+	mock.Mock
+}
+
+func (m *mockSenderRepository) Create(ctx context.Context, s *sender.Sender) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *mockSenderRepository) FindAll(ctx context.Context, tenantID string) ([]sender.Sender, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]sender.Sender), args.Error(1)
+}
+
+func (m *mockSenderRepository) Delete(ctx context.Context, tenantID, id string) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+func newSenderRepository(senders ...sender.Sender) *mockSenderRepository {
+	senderRepository := new(mockSenderRepository)
+	senderRepository.On("FindAll", mock.Anything, mock.Anything).Return(senders, nil).Maybe()
+	return senderRepository
+}
+
+type mockClient struct {
+	mock.Mock
+}
+
+func (m *mockClient) Provider() string {
+	return "sms.example.com"
+}
+
+func (m *mockClient) SendMessage(ctx context.Context, msg entity.Message) (client.Response, error) {
+	args := m.Called(ctx, msg)
+	return client.Response{ID: args.String(0)}, args.Error(1)
+}
+
+// attemptOf matches an attempt of the mock client with the provider message
+// id, status and error class.
+func attemptOf(providerMessageID string, status entity.AttemptStatus, class entity.ErrorClass) any {
+	return mock.MatchedBy(func(attempt *entity.Attempt) bool {
+		return attempt.Provider == "sms.example.com" && attempt.ProviderMessageID == providerMessageID &&
+			attempt.Status == status && attempt.ErrorClass == class
+	})
+}
+
+func newClients(sms client.Client) map[entity.Channel]client.Client {
+	return map[entity.Channel]client.Client{entity.ChannelSMS: sms}
+}
+
+func validMessage() entity.Message {
+	return entity.Message{
+		ID:       uuid.New().String(),
+		TenantID: tenant.DefaultID,
+		Channel:  entity.ChannelSMS,
+		Content:  "This is a valid message content",
+		Phone:    "+905551234567",
+		Status:   entity.StatusPending,
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+	msg := validMessage()
+
+	t.Run("success", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, msg.Content, got.Content)
+		assert.False(t, got.SendAt.IsZero())
+		assert.Equal(t, entity.EncodingGSM7, got.Encoding)
+		assert.Equal(t, 1, got.Segments)
+		assert.Equal(t, "TR", got.Region)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("normalizes phone", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		national := msg
+		national.Phone = "0555 123 45 67"
+		repo.On("Create", ctx, mock.MatchedBy(func(m *entity.Message) bool { return m.Phone == "+905551234567" })).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, national)
+		assert.NoError(t, err)
+		assert.Equal(t, "+905551234567", got.Phone)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("persists metadata and tags", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		tagged := msg
+		tagged.Metadata = map[string]string{"orderId": "42"}
+		tagged.Tags = []string{"order-update", "vip", "order-update"}
+		repo.On("Create", ctx, mock.MatchedBy(func(m *entity.Message) bool {
+			return m.Metadata["orderId"] == "42" && len(m.Tags) == 2
+		})).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, tagged)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"order-update", "vip"}, got.Tags)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		invalid := msg
+		invalid.Content = "short"
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, invalid)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("email", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		mailer := new(mockClient)
+		consents := new(mockConsentRepository)
+		email := entity.Message{
+			Channel:  entity.ChannelEmail,
+			Content:  "Your order is on its way.",
+			Subject:  "Your order has shipped",
+			Email:    "Jane Doe <jane@example.com>",
+			Status:   entity.StatusPending,
+			Category: entity.CategoryMarketing,
+		}
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Return(nil)
+		clients := map[entity.Channel]client.Client{entity.ChannelSMS: cli, entity.ChannelEmail: mailer}
+		svc := message.New(repo, new(mockTemplateRepository), consents, newPolicyRepository(), newSenderRepository(), clients, testConfig)
+		got, err := svc.Create(ctx, email)
+		assert.NoError(t, err)
+		assert.Equal(t, "jane@example.com", got.Email)
+		assert.Empty(t, got.Phone)
+		assert.Zero(t, got.Segments)
+		repo.AssertExpectations(t)
+		consents.AssertNotCalled(t, "FindAllOptedOutPhones", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("email content policy violation", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		mailer := new(mockClient)
+		email := entity.Message{
+			Channel:     entity.ChannelEmail,
+			Content:     "Your order is on its way.",
+			HTMLContent: `<p>Track it at <a href="https://tracking.example.org">example.org</a></p>`,
+			Subject:     "Your order has shipped",
+			Email:       "jane@example.com",
+			Status:      entity.StatusPending,
+		}
+		policies := newPolicyRepository(policy.Policy{Name: "links", AllowedDomains: []string{"example.com"}})
+		clients := map[entity.Channel]client.Client{entity.ChannelSMS: cli, entity.ChannelEmail: mailer}
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), policies, newSenderRepository(), clients, testConfig)
+		got, err := svc.Create(ctx, email)
+		assert.ErrorIs(t, err, policy.ErrContentViolatesPolicy)
+		var violationErr *policy.ViolationError
+		assert.ErrorAs(t, err, &violationErr)
+		assert.Equal(t, policy.RuleDomainNotAllowed, violationErr.Violations[0].Rule)
+		assert.Nil(t, got)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("email without sender", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		email := entity.Message{
+			Channel: entity.ChannelEmail,
+			Content: "Your order is on its way.",
+			Subject: "Your order has shipped",
+			Email:   "jane@example.com",
+			Status:  entity.StatusPending,
+		}
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, email)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreate)
+		assert.Nil(t, got)
+
+		var fieldErr *fault.FieldError
+		assert.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "channel", fieldErr.Field())
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("assigns sender by country", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		senders := newSenderRepository(
+			sender.Sender{Type: sender.TypeAlphanumeric, Value: "Shop"},
+			sender.Sender{Type: sender.TypeNumber, Value: "+447400000000", Countries: []string{"GB"}},
+			sender.Sender{Type: sender.TypeNumber, Value: "+905550000000", Countries: []string{"TR"}},
+		)
+		repo.On("Create", ctx, mock.MatchedBy(func(m *entity.Message) bool { return m.Sender == "+905550000000" })).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), senders, newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, "+905550000000", got.Sender)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("requested sender", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		senders := newSenderRepository(
+			sender.Sender{Type: sender.TypeNumber, Value: "+905550000000", Countries: []string{"TR"}},
+			sender.Sender{Type: sender.TypeAlphanumeric, Value: "Shop"},
+		)
+		requested := msg
+		requested.Sender = "Shop"
+		repo.On("Create", ctx, mock.MatchedBy(func(m *entity.Message) bool { return m.Sender == "Shop" })).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), senders, newClients(cli), testConfig)
+		got, err := svc.Create(ctx, requested)
+		assert.NoError(t, err)
+		assert.Equal(t, "Shop", got.Sender)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("requested sender not in pool", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		requested := msg
+		requested.Sender = "Unknown"
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, requested)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreate)
+		assert.Nil(t, got)
+
+		var fieldErr *fault.FieldError
+		assert.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "from", fieldErr.Field())
+		assert.Equal(t, fault.CodeNotOneOf, fieldErr.Code())
+	})
+
+	t.Run("requested sender not allowed for country", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		senders := newSenderRepository(sender.Sender{Type: sender.TypeNumber, Value: "+447400000000", Countries: []string{"GB"}})
+		requested := msg
+		requested.Sender = "+44 7400 000000"
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), senders, newClients(cli), testConfig)
+		got, err := svc.Create(ctx, requested)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreate)
+		assert.Nil(t, got)
+
+		var fieldErr *fault.FieldError
+		assert.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, fault.CodeNotAllowed, fieldErr.Code())
+	})
+
+	t.Run("scoped to the tenant of the request", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", DefaultRegion: "TR"})
+		local := msg
+		local.Phone = "0555 123 45 67"
+		repo.On("Create", tenantCtx, mock.MatchedBy(func(m *entity.Message) bool {
+			return m.TenantID == "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d" && m.Phone == "+905551234567"
+		})).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Create(tenantCtx, local)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("within quota", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", DailyQuota: 10})
+		repo.On("ReserveQuota", tenantCtx, "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", mock.AnythingOfType("time.Time"), 1, 10).Return(true, nil)
+		repo.On("Create", tenantCtx, mock.AnythingOfType("*message.Message")).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Create(tenantCtx, msg)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("quota exceeded", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", DailyQuota: 1})
+		repo.On("ReserveQuota", tenantCtx, "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", mock.AnythingOfType("time.Time"), 1, 1).Return(false, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(tenantCtx, msg)
+		assert.ErrorIs(t, err, tenant.ErrTenantQuotaExceeded)
+		assert.Nil(t, got)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("quota released on repo error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", DailyQuota: 10})
+		repo.On("ReserveQuota", tenantCtx, "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", mock.AnythingOfType("time.Time"), 1, 10).Return(true, nil)
+		repo.On("Create", tenantCtx, mock.AnythingOfType("*message.Message")).Return(errors.New("db error"))
+		repo.On("ReleaseQuota", tenantCtx, "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", mock.AnythingOfType("time.Time"), 1).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Create(tenantCtx, msg)
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("from template", func(t *testing.T) {
+		repo := new(mockRepository)
+		templates := new(mockTemplateRepository)
+		cli := new(mockClient)
+		tmpl := &template.Template{
+			ID:            uuid.New().String(),
+			DefaultLocale: "en",
+			Variants: []template.Variant{
+				{Locale: "en", Content: "Your code is {{code}}."},
+				{Locale: "tr", Content: "Doğrulama kodunuz {{code}}."},
+			},
+		}
+		fromTemplate := msg
+		fromTemplate.Content = ""
+		fromTemplate.TemplateID = tmpl.ID
+		fromTemplate.Variables = map[string]string{"code": "123456"}
+		templates.On("FindByID", ctx, tenant.DefaultID, tmpl.ID).Return(tmpl, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Return(nil)
+		svc := message.New(repo, templates, newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, fromTemplate)
+		assert.NoError(t, err)
+		assert.Equal(t, "Doğrulama kodunuz 123456.", got.Content)
+		assert.Equal(t, "tr", got.Locale)
+		assert.Equal(t, entity.EncodingUCS2, got.Encoding)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("template variables missing", func(t *testing.T) {
+		repo := new(mockRepository)
+		templates := new(mockTemplateRepository)
+		cli := new(mockClient)
+		tmpl := &template.Template{
+			ID:            uuid.New().String(),
+			DefaultLocale: "en",
+			Variants:      []template.Variant{{Locale: "en", Content: "Your code is {{code}}."}},
+		}
+		fromTemplate := msg
+		fromTemplate.Content = ""
+		fromTemplate.TemplateID = tmpl.ID
+		templates.On("FindByID", ctx, tenant.DefaultID, tmpl.ID).Return(tmpl, nil)
+		svc := message.New(repo, templates, newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, fromTemplate)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreate)
+		assert.ErrorIs(t, err, template.ErrTemplateVariablesMissing)
+		assert.Nil(t, got)
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		repo := new(mockRepository)
+		templates := new(mockTemplateRepository)
+		cli := new(mockClient)
+		fromTemplate := msg
+		fromTemplate.Content = ""
+		fromTemplate.TemplateID = uuid.New().String()
+		templates.On("FindByID", ctx, tenant.DefaultID, fromTemplate.TemplateID).Return((*template.Template)(nil), postgresql.ErrNoRows)
+		svc := message.New(repo, templates, newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, fromTemplate)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreate)
+		assert.Nil(t, got)
+	})
+
+	t.Run("content policy violation", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		policies := newPolicyRepository(policy.Policy{Name: "marketing", RequiredFooter: "Reply STOP to opt out."})
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), policies, newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreate)
+		var violationErr *policy.ViolationError
+		assert.ErrorAs(t, err, &violationErr)
+		assert.Equal(t, policy.RuleRequiredFooter, violationErr.Violations[0].Rule)
+		assert.Nil(t, got)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("configured content policy", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		config := testConfig
+		config.ContentPolicies = []policy.Policy{{Name: "config", ForbiddenWords: []string{"valid"}}}
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), config)
+		got, err := svc.Create(ctx, msg)
+		assert.ErrorIs(t, err, policy.ErrContentViolatesPolicy)
+		assert.Nil(t, got)
+	})
+
+	t.Run("recipient opted out", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(msg.Phone), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.ErrorIs(t, err, entity.ErrMessageRecipientOptedOut)
+		assert.Nil(t, got)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("transactional ignores opt-out", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		consents := new(mockConsentRepository)
+		transactional := msg
+		transactional.Category = entity.CategoryTransactional
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), consents, newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, transactional)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.CategoryTransactional, got.Category)
+		consents.AssertNotCalled(t, "FindAllOptedOutPhones", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Return(errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Create(ctx, msg)
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_CreateIdempotent(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+	msg := validMessage()
+	msg.ID = ""
+	msg.IdempotencyKey = "order-42"
+
+	t.Run("first request", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		id := uuid.New().String()
+		repo.On("ReserveIdempotencyKey", ctx, mock.MatchedBy(func(r entity.IdempotencyRecord) bool {
+			return r.Key == "order-42" && r.Fingerprint == msg.Fingerprint() && r.MessageID == ""
+		}), time.Minute).Return((*entity.IdempotencyRecord)(nil), nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Message).ID = id
+		}).Return(nil)
+		repo.On("CompleteIdempotencyKey", ctx, mock.MatchedBy(func(r entity.IdempotencyRecord) bool {
+			return r.Key == "order-42" && r.MessageID == id
+		}), 24*time.Hour).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, id, got.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("retries completing the key", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		id := uuid.New().String()
+		repo.On("ReserveIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), time.Minute).Return((*entity.IdempotencyRecord)(nil), nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Message).ID = id
+		}).Return(nil)
+		repo.On("CompleteIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), 24*time.Hour).Return(errors.New("redis error")).Once()
+		repo.On("CompleteIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), 24*time.Hour).Return(nil).Once()
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, id, got.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("returns the created message when the key cannot be completed", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		id := uuid.New().String()
+		var reported []error
+		repo.On("ReserveIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), time.Minute).Return((*entity.IdempotencyRecord)(nil), nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Message).ID = id
+		}).Return(nil)
+		repo.On("CompleteIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), 24*time.Hour).Return(errors.New("redis error"))
+		config := testConfig
+		config.OnError = func(err error) {
+			reported = append(reported, err)
+		}
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), config)
+		got, err := svc.Create(ctx, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, id, got.ID)
+		assert.Len(t, reported, 1)
+		repo.AssertNumberOfCalls(t, "CompleteIdempotencyKey", 3)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		existing := validMessage()
+		repo.On("ReserveIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), time.Minute).Return(&entity.IdempotencyRecord{
+			Key:         "order-42",
+			Fingerprint: msg.Fingerprint(),
+			MessageID:   existing.ID,
+		}, nil)
+		repo.On("FindByID", ctx, tenant.DefaultID, existing.ID).Return(&existing, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, existing.ID, got.ID)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("reused with a different request", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("ReserveIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), time.Minute).Return(&entity.IdempotencyRecord{
+			Key:         "order-42",
+			Fingerprint: "another request",
+			MessageID:   uuid.New().String(),
+		}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.ErrorIs(t, err, entity.ErrMessageIdempotencyKeyReused)
+		assert.Nil(t, got)
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("ReserveIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), time.Minute).Return(&entity.IdempotencyRecord{
+			Key:         "order-42",
+			Fingerprint: msg.Fingerprint(),
+		}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, msg)
+		assert.ErrorIs(t, err, entity.ErrMessageIdempotencyKeyInProgress)
+		assert.Nil(t, got)
+	})
+
+	t.Run("releases key on invalid message", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		invalid := msg
+		invalid.Content = "short"
+		repo.On("ReserveIdempotencyKey", ctx, mock.AnythingOfType("message.IdempotencyRecord"), time.Minute).Return((*entity.IdempotencyRecord)(nil), nil)
+		repo.On("ReleaseIdempotencyKey", ctx, tenant.DefaultID, "order-42").Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Create(ctx, invalid)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreate)
+		assert.Nil(t, got)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_CreateBatch(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+
+	t.Run("partial failure", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		valid := validMessage()
+		invalid := validMessage()
+		invalid.Content = "short"
+		repo.On("CreateAll", ctx, mock.MatchedBy(func(msgs []*entity.Message) bool {
+			return len(msgs) == 2 && msgs[0].Encoding == entity.EncodingGSM7
+		})).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		results, err := svc.CreateBatch(ctx, []entity.Message{valid, invalid, valid})
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+		assert.NotNil(t, results[0].Message)
+		assert.ErrorIs(t, results[1].Err, entity.ErrMessageDoesNotValidForCreate)
+		assert.Nil(t, results[1].Message)
+		assert.NotNil(t, results[2].Message)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("recipient opted out", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		valid := validMessage()
+		optedOut := validMessage()
+		optedOut.Phone = "+905559876543"
+		repo.On("CreateAll", ctx, mock.MatchedBy(func(msgs []*entity.Message) bool {
+			return len(msgs) == 1 && msgs[0].Phone == valid.Phone
+		})).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(optedOut.Phone), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		results, err := svc.CreateBatch(ctx, []entity.Message{valid, optedOut})
+		assert.NoError(t, err)
+		assert.NotNil(t, results[0].Message)
+		assert.ErrorIs(t, results[1].Err, entity.ErrMessageRecipientOptedOut)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("all invalid", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		invalid := validMessage()
+		invalid.Phone = ""
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		results, err := svc.CreateBatch(ctx, []entity.Message{invalid})
+		assert.NoError(t, err)
+		assert.Error(t, results[0].Err)
+		repo.AssertNotCalled(t, "CreateAll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		results, err := svc.CreateBatch(ctx, nil)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreateBatch)
+		assert.Nil(t, results)
+	})
+
+	t.Run("batch too large", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		msgs := []entity.Message{validMessage(), validMessage(), validMessage(), validMessage()}
+		results, err := svc.CreateBatch(ctx, msgs)
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForCreateBatch)
+		assert.Nil(t, results)
+	})
 
-func (m *mockRepository) FindAllByStatus(ctx context.Context, status entity.Status) ([]entity.Message, error) {
-	args := m.Called(ctx, status)
-	return args.Get(0).([]entity.Message), args.Error(1)
+	t.Run("repo error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("CreateAll", ctx, mock.Anything).Return(errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		results, err := svc.CreateBatch(ctx, []entity.Message{validMessage()})
+		assert.Error(t, err)
+		assert.Nil(t, results)
+	})
 }
 
-func (m *mockRepository) FindByID(ctx context.Context, id string) (*entity.Message, error) {
-	args := m.Called(ctx, id)
-	return args.Get(0).(*entity.Message), args.Error(1)
-}
+func TestService_ListByStatus(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+	status := entity.ListFilter{TenantID: tenant.DefaultID, Statuses: []entity.Status{entity.StatusPending}, Limit: entity.DefaultListLimit}
+	msg := validMessage()
 
-func (m *mockRepository) UpdateAllStatusesByStatus(ctx context.Context, from, to entity.Status) error {
-	args := m.Called(ctx, from, to)
-	return args.Error(0)
-}
+	t.Run("success", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("FindAllByStatus", ctx, status).Return(&entity.Page{Items: []entity.Message{msg}}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.ListByStatus(ctx, entity.ListFilter{Statuses: []entity.Status{entity.StatusPending}})
+		assert.NoError(t, err)
+		assert.Len(t, got.Items, 1)
+		repo.AssertExpectations(t)
+	})
 
-func (m *mockRepository) CreateSentInfo(ctx context.Context, messageID, t string) error {
-	args := m.Called(ctx, messageID, t)
-	return args.Error(0)
-}
+	t.Run("by tag and metadata", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		filter := entity.ListFilter{TenantID: tenant.DefaultID, Statuses: []entity.Status{entity.StatusSent}, Tag: "order-update", MetadataKey: "orderId", MetadataValue: "42", Limit: 10}
+		repo.On("FindAllByStatus", ctx, filter).Return(&entity.Page{Items: []entity.Message{msg}}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.ListByStatus(ctx, filter)
+		assert.NoError(t, err)
+		assert.Len(t, got.Items, 1)
+		repo.AssertExpectations(t)
+	})
 
-type mockClient struct {
-	mock.Mock
-}
+	t.Run("by phone and content in any status", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		filter := entity.ListFilter{TenantID: tenant.DefaultID, Phone: "+905551234567", Query: "shipped", Limit: entity.DefaultListLimit}
+		repo.On("FindAllByStatus", ctx, filter).Return(&entity.Page{Items: []entity.Message{msg}, NextCursor: entity.NewCursor(msg)}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.ListByStatus(ctx, entity.ListFilter{Phone: " +905551234567", Query: "shipped "})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, got.NextCursor)
+		repo.AssertExpectations(t)
+	})
 
-func (m *mockClient) SendMessage(ctx context.Context, msg entity.Message) (string, error) {
-	args := m.Called(ctx, msg)
-	return args.String(0), args.Error(1)
-}
+	t.Run("by phone in the region of the tenant", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", DefaultRegion: "US"})
+		filter := entity.ListFilter{TenantID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", Phone: "+12025550143", Limit: entity.DefaultListLimit}
+		repo.On("FindAllByStatus", tenantCtx, filter).Return(&entity.Page{}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListByStatus(tenantCtx, entity.ListFilter{Phone: "(202) 555-0143"})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
 
-func validMessage() entity.Message {
-	return entity.Message{
-		ID:      uuid.New().String(),
-		Content: "This is a valid message content",
-		Phone:   "+905551234567",
-		Status:  entity.StatusPending,
-	}
+	t.Run("scoped to the tenant of the request", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		tenantCtx := tenant.NewContext(ctx, tenant.Tenant{ID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d"})
+		repo.On("FindAllByStatus", tenantCtx, entity.ListFilter{TenantID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", Statuses: []entity.Status{entity.StatusPending}, Limit: entity.DefaultListLimit}).Return(&entity.Page{}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListByStatus(tenantCtx, entity.ListFilter{TenantID: tenant.DefaultID, Statuses: []entity.Status{entity.StatusPending}})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("metadata value without key", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListByStatus(ctx, entity.ListFilter{Statuses: []entity.Status{entity.StatusSent}, MetadataValue: "42"})
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForListByStatus)
+		repo.AssertNotCalled(t, "FindAllByStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListByStatus(ctx, entity.ListFilter{Statuses: []entity.Status{"INVALID"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListByStatus(ctx, entity.ListFilter{Cursor: "invalid"})
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForListByStatus)
+		repo.AssertNotCalled(t, "FindAllByStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("FindAllByStatus", ctx, status).Return((*entity.Page)(nil), errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListByStatus(ctx, status)
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
 }
 
-func TestService_Create(t *testing.T) {
+func TestService_Get(t *testing.T) {
 	t.Parallel()
-	ctx := context.Background()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
 	msg := validMessage()
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("with attempts", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Return(nil)
-		svc := message.New(repo, cli)
-		got, err := svc.Create(ctx, msg)
+		found := msg
+		attempts := []entity.Attempt{
+			{MessageID: msg.ID, Provider: "sms.example.com", Status: entity.AttemptStatusFailed, ResponseStatus: 503, ErrorClass: entity.ErrorClassTemporary},
+			{MessageID: msg.ID, Provider: "sms.example.com", ProviderMessageID: "sent-id", Status: entity.AttemptStatusSucceeded, ResponseStatus: 202},
+		}
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		repo.On("FindAllAttemptsByMessageID", ctx, tenant.DefaultID, msg.ID).Return(attempts, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.Get(ctx, msg.ID)
 		assert.NoError(t, err)
-		assert.Equal(t, msg.Content, got.Content)
+		assert.Equal(t, msg.ID, got.ID)
+		assert.Equal(t, attempts, got.Attempts)
 		repo.AssertExpectations(t)
 	})
 
-	t.Run("invalid message", func(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		invalid := msg
-		invalid.Content = "short"
-		svc := message.New(repo, cli)
-		got, err := svc.Create(ctx, invalid)
-		assert.Error(t, err)
-		assert.Nil(t, got)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Get(ctx, "invalid-uuid")
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForGet)
+		repo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return((*entity.Message)(nil), postgresql.ErrNoRows)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Get(ctx, msg.ID)
+		assert.ErrorIs(t, err, entity.ErrMessageNotFound)
+		repo.AssertNotCalled(t, "FindAllAttemptsByMessageID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("without a tenant", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Get(context.Background(), msg.ID)
+		assert.ErrorIs(t, err, tenant.ErrTenantMissing)
+		repo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("Create", ctx, mock.AnythingOfType("*message.Message")).Return(errors.New("db error"))
-		svc := message.New(repo, cli)
-		_, err := svc.Create(ctx, msg)
+		found := msg
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		repo.On("FindAllAttemptsByMessageID", ctx, tenant.DefaultID, msg.ID).Return([]entity.Attempt{}, errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.Get(ctx, msg.ID)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
 	})
 }
 
-func TestService_ListByStatus(t *testing.T) {
+func TestService_ListEvents(t *testing.T) {
 	t.Parallel()
-	ctx := context.Background()
-	status := entity.StatusPending
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
 	msg := validMessage()
 
 	t.Run("success", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindAllByStatus", ctx, status).Return([]entity.Message{msg}, nil)
-		svc := message.New(repo, cli)
-		got, err := svc.ListByStatus(ctx, status)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&msg, nil)
+		repo.On("FindAllEventsByMessageID", ctx, tenant.DefaultID, msg.ID).Return([]entity.Event{{MessageID: msg.ID, To: entity.StatusPending}}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		got, err := svc.ListEvents(ctx, msg.ID)
 		assert.NoError(t, err)
 		assert.Len(t, got, 1)
 		repo.AssertExpectations(t)
 	})
 
-	t.Run("invalid status", func(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		svc := message.New(repo, cli)
-		_, err := svc.ListByStatus(ctx, "INVALID")
-		assert.Error(t, err)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListEvents(ctx, "invalid-uuid")
+		assert.ErrorIs(t, err, entity.ErrMessageDoesNotValidForListEvents)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return((*entity.Message)(nil), postgresql.ErrNoRows)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListEvents(ctx, msg.ID)
+		assert.ErrorIs(t, err, entity.ErrMessageNotFound)
+		repo.AssertExpectations(t)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindAllByStatus", ctx, status).Return([]entity.Message{}, errors.New("db error"))
-		svc := message.New(repo, cli)
-		_, err := svc.ListByStatus(ctx, status)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&msg, nil)
+		repo.On("FindAllEventsByMessageID", ctx, tenant.DefaultID, msg.ID).Return([]entity.Event{}, errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		_, err := svc.ListEvents(ctx, msg.ID)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
 	})
@@ -137,54 +1087,217 @@ func TestService_ListByStatus(t *testing.T) {
 
 func TestService_Process(t *testing.T) {
 	t.Parallel()
-	ctx := context.Background()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
 
 	t.Run("success", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("UpdateAllStatusesByStatus", ctx, entity.StatusPending, entity.StatusSent).Return(nil)
-		svc := message.New(repo, cli)
+		var claimed []entity.Priority
+		for _, priority := range entity.Priorities {
+			repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusQueued, entity.StatusSending, priority, testConfig.BatchSizes[priority]).
+				Return([]entity.Message{validMessage()}, nil)
+			repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusPending, entity.StatusSending, priority, testConfig.BatchSizes[priority]-1).
+				Run(func(mock.Arguments) { claimed = append(claimed, priority) }).
+				Return([]entity.Message{validMessage()}, nil)
+		}
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Process(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Priority{entity.PriorityTransactional, entity.PriorityNormal, entity.PriorityBulk}, claimed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("requeues stale claims", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		var updatedBefore time.Time
+		repo.On("RequeueAllByStatus", ctx, entity.StatusSending, mock.AnythingOfType("time.Time")).
+			Run(func(args mock.Arguments) { updatedBefore = args.Get(2).(time.Time) }).
+			Return(nil)
+		repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusQueued, entity.StatusSending, entity.PriorityTransactional, 10).Return([]entity.Message{}, nil)
+		repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusPending, entity.StatusSending, entity.PriorityTransactional, 10).Return([]entity.Message{}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), message.Config{
+			BatchSizes: map[entity.Priority]int{
+				entity.PriorityTransactional: 10,
+			},
+			ClaimTimeout: 5 * time.Minute,
+		})
+		err := svc.Process(ctx)
+		assert.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(-5*time.Minute), updatedBefore, time.Second)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("requeue error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("RequeueAllByStatus", ctx, entity.StatusSending, mock.AnythingOfType("time.Time")).Return(errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), message.Config{
+			ClaimTimeout: 5 * time.Minute,
+		})
+		err := svc.Process(ctx)
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("skips lanes without quota", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusQueued, entity.StatusSending, entity.PriorityTransactional, 10).Return([]entity.Message{}, nil)
+		repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusPending, entity.StatusSending, entity.PriorityTransactional, 10).Return([]entity.Message{}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), message.Config{
+			BatchSizes: map[entity.Priority]int{
+				entity.PriorityTransactional: 10,
+			},
+		})
+		err := svc.Process(ctx)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("queued messages fill the batch first", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusQueued, entity.StatusSending, entity.PriorityTransactional, 2).Return([]entity.Message{validMessage(), validMessage()}, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), message.Config{
+			BatchSizes: map[entity.Priority]int{
+				entity.PriorityTransactional: 2,
+			},
+		})
 		err := svc.Process(ctx)
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
+		repo.AssertNumberOfCalls(t, "ClaimAllByStatusAndPriority", 1)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("UpdateAllStatusesByStatus", ctx, entity.StatusPending, entity.StatusSent).Return(errors.New("db error"))
-		svc := message.New(repo, cli)
+		repo.On("ClaimAllByStatusAndPriority", ctx, entity.StatusQueued, entity.StatusSending, entity.PriorityTransactional, testConfig.BatchSizes[entity.PriorityTransactional]).Return([]entity.Message{}, errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Process(ctx)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
 	})
 }
 
+func TestService_Expire(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+
+	t.Run("success", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("ExpireAllByStatus", ctx, entity.StatusPending).Return(nil)
+		repo.On("ExpireAllByStatus", ctx, entity.StatusQueued).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Expire(ctx)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("ExpireAllByStatus", ctx, entity.StatusPending).Return(errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Expire(ctx)
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
 func TestService_Sent(t *testing.T) {
 	t.Parallel()
-	ctx := context.Background()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
 	msg := validMessage()
-	msg.Status = entity.StatusSent
+	msg.Status = entity.StatusSending
 
 	t.Run("success", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindByID", ctx, msg.ID).Return(&msg, nil)
+		found := msg
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
 		cli.On("SendMessage", ctx, msg).Return("sent-id", nil)
-		repo.On("CreateSentInfo", ctx, "sent-id", mock.AnythingOfType("string")).Return(nil)
-		svc := message.New(repo, cli)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusSent).Return(nil)
+		repo.On("CreateAttempt", ctx, attemptOf("sent-id", entity.AttemptStatusSucceeded, "")).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, msg)
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
 		cli.AssertExpectations(t)
 	})
 
+	t.Run("email", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		mailer := new(mockClient)
+		found := msg
+		found.Channel = entity.ChannelEmail
+		found.Phone = ""
+		found.Email = "jane@example.com"
+		found.Subject = "Your order has shipped"
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		mailer.On("SendMessage", ctx, found).Return("<id@example.com>", nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusSent).Return(nil)
+		repo.On("CreateAttempt", ctx, attemptOf("<id@example.com>", entity.AttemptStatusSucceeded, "")).Return(nil)
+		clients := map[entity.Channel]client.Client{entity.ChannelSMS: cli, entity.ChannelEmail: mailer}
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), clients, testConfig)
+		err := svc.Sent(ctx, msg)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		mailer.AssertExpectations(t)
+		cli.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("channel not supported", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		found.Channel = entity.ChannelEmail
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusFailed).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Sent(ctx, msg)
+		assert.ErrorIs(t, err, entity.ErrMessageChannelNotSupported)
+		repo.AssertExpectations(t)
+		cli.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		found.ValidUntil = time.Now().Add(-time.Minute)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusExpired).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Sent(ctx, msg)
+		assert.ErrorIs(t, err, entity.ErrMessageExpired)
+		repo.AssertExpectations(t)
+		cli.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("recipient opted out", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		found.Category = entity.CategoryMarketing
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusCancelled).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(msg.Phone), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Sent(ctx, msg)
+		assert.ErrorIs(t, err, entity.ErrMessageRecipientOptedOut)
+		repo.AssertExpectations(t)
+		cli.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
 	t.Run("invalid message", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
 		invalid := msg
 		invalid.ID = ""
-		svc := message.New(repo, cli)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, invalid)
 		assert.Error(t, err)
 	})
@@ -192,8 +1305,8 @@ func TestService_Sent(t *testing.T) {
 	t.Run("not found", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindByID", ctx, msg.ID).Return((*entity.Message)(nil), errors.New("not found"))
-		svc := message.New(repo, cli)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return((*entity.Message)(nil), errors.New("not found"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, msg)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
@@ -204,8 +1317,8 @@ func TestService_Sent(t *testing.T) {
 		cli := new(mockClient)
 		m := msg
 		m.Status = entity.StatusPending
-		repo.On("FindByID", ctx, m.ID).Return(&m, nil)
-		svc := message.New(repo, cli)
+		repo.On("FindByID", ctx, tenant.DefaultID, m.ID).Return(&m, nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, m)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
@@ -214,22 +1327,101 @@ func TestService_Sent(t *testing.T) {
 	t.Run("client error", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindByID", ctx, msg.ID).Return(&msg, nil)
+		found := msg
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
 		cli.On("SendMessage", ctx, msg).Return("", errors.New("client error"))
-		svc := message.New(repo, cli)
+		repo.On("CreateAttempt", ctx, attemptOf("", entity.AttemptStatusFailed, entity.ErrorClassPermanent)).Return(nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusFailed).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Sent(ctx, msg)
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("quiet hours", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		found.Category = entity.CategoryMarketing
+		found.Region = "TR"
+		location, err := found.RecipientLocation()
+		assert.NoError(t, err)
+		now := time.Now().In(location)
+		clock := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute
+		config := testConfig
+		config.QuietHours = map[entity.Category]entity.QuietHours{
+			entity.CategoryMarketing: {Start: (clock + 23*time.Hour) % (24 * time.Hour), End: (clock + 2*time.Hour) % (24 * time.Hour)},
+		}
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		repo.On("Reschedule", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, mock.MatchedBy(func(sendAt time.Time) bool {
+			return sendAt.After(now.Add(time.Hour)) && !sendAt.After(now.Add(2*time.Hour))
+		})).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), config)
+		err = svc.Sent(ctx, msg)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		cli.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("quiet hours of another category", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		found.Category = entity.CategoryTransactional
+		config := testConfig
+		config.QuietHours = map[entity.Category]entity.QuietHours{
+			entity.CategoryMarketing: {Start: 0, End: 24*time.Hour - time.Minute},
+		}
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		cli.On("SendMessage", ctx, found).Return("sent-id", nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusSent).Return(nil)
+		repo.On("CreateAttempt", ctx, attemptOf("sent-id", entity.AttemptStatusSucceeded, "")).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), config)
+		err := svc.Sent(ctx, msg)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("client temporary error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		cli.On("SendMessage", ctx, msg).Return("", client.ErrTemporary)
+		repo.On("CreateAttempt", ctx, attemptOf("", entity.AttemptStatusFailed, entity.ErrorClassTemporary)).Return(nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusPending).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Sent(ctx, msg)
+		assert.ErrorIs(t, err, client.ErrTemporary)
+		repo.AssertExpectations(t)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("repo UpdateStatus error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
+		cli.On("SendMessage", ctx, msg).Return("sent-id", nil)
+		repo.On("CreateAttempt", ctx, attemptOf("sent-id", entity.AttemptStatusSucceeded, "")).Return(nil)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusSent).Return(errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, msg)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
 		cli.AssertExpectations(t)
 	})
 
-	t.Run("repo CreateSentInfo error", func(t *testing.T) {
+	t.Run("repo CreateAttempt error still marks the message sent", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindByID", ctx, msg.ID).Return(&msg, nil)
+		found := msg
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&found, nil)
 		cli.On("SendMessage", ctx, msg).Return("sent-id", nil)
-		repo.On("CreateSentInfo", ctx, "sent-id", mock.AnythingOfType("string")).Return(errors.New("db error"))
-		svc := message.New(repo, cli)
+		repo.On("UpdateStatus", ctx, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusSent).Return(nil)
+		repo.On("CreateAttempt", ctx, attemptOf("sent-id", entity.AttemptStatusSucceeded, "")).Return(errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, msg)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
@@ -239,8 +1431,8 @@ func TestService_Sent(t *testing.T) {
 	t.Run("not found with ErrNoRows", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindByID", ctx, msg.ID).Return((*entity.Message)(nil), postgresql.ErrNoRows)
-		svc := message.New(repo, cli)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return((*entity.Message)(nil), postgresql.ErrNoRows)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, msg)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
@@ -249,10 +1441,136 @@ func TestService_Sent(t *testing.T) {
 	t.Run("FindByID returns error with non-nil message", func(t *testing.T) {
 		repo := new(mockRepository)
 		cli := new(mockClient)
-		repo.On("FindByID", ctx, msg.ID).Return(&msg, errors.New("unexpected error"))
-		svc := message.New(repo, cli)
+		repo.On("FindByID", ctx, tenant.DefaultID, msg.ID).Return(&msg, errors.New("unexpected error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
 		err := svc.Sent(ctx, msg)
 		assert.Error(t, err)
 		repo.AssertExpectations(t)
 	})
 }
+
+func TestService_Relay(t *testing.T) {
+	t.Parallel()
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+	msg := validMessage()
+	msg.Status = entity.StatusSending
+	entries := []entity.OutboxEntry{
+		{ID: 1, MessageID: msg.ID, TenantID: tenant.DefaultID, From: entity.StatusPending, To: entity.StatusSending},
+		{ID: 2, MessageID: uuid.New().String(), TenantID: tenant.DefaultID, From: entity.StatusSending, To: entity.StatusSent},
+	}
+
+	t.Run("publishes to the publisher", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		publisher := new(mockPublisher)
+		repo.On("RelayOutbox", ctx, 100).Return(entries, nil)
+		publisher.On("Publish", ctx, entries).Return(nil)
+		config := testConfig
+		config.Publisher = publisher
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), config)
+		assert.NoError(t, svc.Relay(ctx))
+		publisher.AssertExpectations(t)
+		cli.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("keeps the entries when publishing fails", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		publisher := new(mockPublisher)
+		repo.On("RelayOutbox", ctx, 100).Return(entries, nil)
+		publisher.On("Publish", ctx, entries).Return(errors.New("kafka error"))
+		config := testConfig
+		config.Publisher = publisher
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), config)
+		assert.Error(t, svc.Relay(ctx))
+	})
+
+	t.Run("dispatches claimed messages without a publisher", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		found := msg
+		repo.On("RelayOutbox", ctx, 100).Return(entries, nil)
+		repo.On("FindByID", mock.Anything, tenant.DefaultID, msg.ID).Return(&found, nil).Once()
+		cli.On("SendMessage", mock.Anything, msg).Return("sent-id", nil)
+		repo.On("UpdateStatus", mock.Anything, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusSent).Return(nil)
+		repo.On("CreateAttempt", mock.Anything, attemptOf("sent-id", entity.AttemptStatusSucceeded, "")).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		assert.NoError(t, svc.Relay(ctx))
+		repo.AssertExpectations(t)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("reports failed dispatches", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("RelayOutbox", ctx, 100).Return(entries, nil)
+		repo.On("FindByID", mock.Anything, tenant.DefaultID, msg.ID).Return((*entity.Message)(nil), postgresql.ErrNoRows)
+		repo.On("UpdateStatus", mock.Anything, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusPending).Return(postgresql.ErrNoRows)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		err := svc.Relay(ctx)
+		assert.ErrorIs(t, err, entity.ErrMessageNotFound)
+		assert.NotErrorIs(t, err, postgresql.ErrNoRows)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("requeues failed dispatches", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("RelayOutbox", ctx, 100).Return(entries, nil)
+		repo.On("FindByID", mock.Anything, tenant.DefaultID, msg.ID).Return((*entity.Message)(nil), errors.New("db error"))
+		repo.On("UpdateStatus", mock.Anything, tenant.DefaultID, msg.ID, entity.StatusSending, entity.StatusPending).Return(nil)
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		assert.Error(t, svc.Relay(ctx))
+		repo.AssertExpectations(t)
+		cli.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("does not dispatch when relaying fails", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("RelayOutbox", ctx, 100).Return(entries, errors.New("commit error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		assert.Error(t, svc.Relay(ctx))
+		repo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		repo := new(mockRepository)
+		cli := new(mockClient)
+		repo.On("RelayOutbox", ctx, 100).Return(nil, errors.New("db error"))
+		svc := message.New(repo, new(mockTemplateRepository), newConsentRepository(), newPolicyRepository(), newSenderRepository(), newClients(cli), testConfig)
+		assert.Error(t, svc.Relay(ctx))
+	})
+}
+
+// TestService_ProcessQueued sends a message held in QUEUED by quiet hours once
+// its send at passes, on the embedded store.
+func TestService_ProcessQueued(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+	store := memory.NewStore()
+	repo := memory.NewMessageRepository(store, redis.NewMemory())
+	cli := new(mockClient)
+	cli.On("SendMessage", mock.Anything, mock.Anything).Return("sent-id", nil).Once()
+	svc := message.New(repo, memory.NewTemplateRepository(store), memory.NewConsentRepository(store),
+		memory.NewPolicyRepository(store), memory.NewSenderRepository(store), newClients(cli), testConfig)
+
+	queued := validMessage()
+	queued.TenantID = tenant.DefaultID
+	queued.Status = entity.StatusPending
+	queued.Priority = entity.PriorityNormal
+	queued.SendAt = time.Now().Add(-time.Hour)
+	assert.NoError(t, repo.Create(ctx, &queued))
+	assert.NoError(t, repo.UpdateStatus(ctx, tenant.DefaultID, queued.ID, entity.StatusPending, entity.StatusSending))
+	assert.NoError(t, repo.Reschedule(ctx, tenant.DefaultID, queued.ID, entity.StatusSending, time.Now().Add(-time.Minute)))
+
+	// The entries up to the reschedule were relayed before quiet hours ended.
+	assert.NoError(t, repo.RelayOutbox(ctx, 100, func([]entity.OutboxEntry) error { return nil }))
+
+	assert.NoError(t, svc.Process(ctx))
+	assert.NoError(t, svc.Relay(ctx))
+
+	found, err := repo.FindByID(ctx, tenant.DefaultID, queued.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.StatusSent, found.Status)
+	cli.AssertExpectations(t)
+}
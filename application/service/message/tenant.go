@@ -0,0 +1,62 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+	"messager/domain/tenant"
+)
+
+// createOptions returns the create options of current, whose phone numbers
+// are parsed in its own default region when it has one.
+func (s *service) createOptions(current tenant.Tenant) message.CreateOptions {
+	options := s.config.CreateOptions
+	options.DefaultRegion = current.Region(options.DefaultRegion)
+
+	return options
+}
+
+// reserveQuota counts count messages against the daily quota of the tenant of
+// ctx. Tenants without a quota are not counted.
+func (s *service) reserveQuota(ctx context.Context, count int) error {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	if !current.HasQuota() {
+		return nil
+	}
+
+	reserved, err := s.repository.ReserveQuota(ctx, current.ID, time.Now(), count, current.DailyQuota)
+	if err != nil {
+		return fmt.Errorf("service.repository.ReserveQuota(): %w", err)
+	}
+
+	if !reserved {
+		return current.NewErrTenantQuotaExceeded()
+	}
+
+	return nil
+}
+
+// releaseQuota gives back the quota reserved for messages that could not be
+// persisted.
+func (s *service) releaseQuota(ctx context.Context, count int) error {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	if !current.HasQuota() {
+		return nil
+	}
+
+	if err := s.repository.ReleaseQuota(ctx, current.ID, time.Now(), count); err != nil {
+		return fmt.Errorf("service.repository.ReleaseQuota(): %w", err)
+	}
+
+	return nil
+}
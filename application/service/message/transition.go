@@ -0,0 +1,46 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+	entity "messager/domain/message"
+)
+
+func (s *service) transition(ctx context.Context, message *message.Message, to message.Status) error {
+	from := message.Status
+
+	if err := message.TransitionTo(to); err != nil {
+		return err
+	}
+
+	if err := s.repository.UpdateStatus(ctx, message.TenantID, message.ID, from, to); err != nil {
+		message.Status = from
+
+		return fmt.Errorf("service.repository.UpdateStatus(): %w", err)
+	}
+
+	return nil
+}
+
+// reschedule holds the message in QUEUED so that it is claimed again at send
+// at.
+func (s *service) reschedule(ctx context.Context, message *message.Message, sendAt time.Time) error {
+	from := message.Status
+
+	if err := message.TransitionTo(entity.StatusQueued); err != nil {
+		return err
+	}
+
+	if err := s.repository.Reschedule(ctx, message.TenantID, message.ID, from, sendAt); err != nil {
+		message.Status = from
+
+		return fmt.Errorf("service.repository.Reschedule(): %w", err)
+	}
+
+	message.SendAt = sendAt.UTC()
+
+	return nil
+}
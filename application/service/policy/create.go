@@ -0,0 +1,30 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/policy"
+	"messager/domain/tenant"
+)
+
+func (s *service) Create(ctx context.Context, policy policy.Policy) (*policy.Policy, error) {
+	if err := policy.ValidateForCreate(); err != nil {
+		return nil, errors.Join(policy.NewErrPolicyDoesNotValidForCreate(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	policy.Normalize()
+	policy.TenantID = current.ID
+
+	if err := s.repository.Create(ctx, &policy); err != nil {
+		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+	}
+
+	return &policy, nil
+}
@@ -0,0 +1,36 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/policy"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	policy := policy.Policy{
+		ID: id,
+	}
+
+	if err := policy.ValidateForFind(); err != nil {
+		return errors.Join(policy.NewErrPolicyDoesNotValidForFind(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	err := s.repository.Delete(ctx, current.ID, id)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return policy.NewErrPolicyNotFound()
+	}
+	if err != nil {
+		return fmt.Errorf("service.repository.Delete(): %w", err)
+	}
+
+	return nil
+}
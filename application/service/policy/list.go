@@ -0,0 +1,23 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/policy"
+	"messager/domain/tenant"
+)
+
+func (s *service) List(ctx context.Context) ([]policy.Policy, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	policies, err := s.repository.FindAll(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAll(): %w", err)
+	}
+
+	return policies, nil
+}
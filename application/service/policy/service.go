@@ -0,0 +1,15 @@
+package policy
+
+import (
+	"messager/domain/policy"
+)
+
+type service struct {
+	repository policy.Repository
+}
+
+func New(repository policy.Repository) policy.Service {
+	return &service{
+		repository: repository,
+	}
+}
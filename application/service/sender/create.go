@@ -0,0 +1,40 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/sender"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Create(ctx context.Context, sender sender.Sender) (*sender.Sender, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	region := current.Region(s.config.DefaultRegion)
+
+	if err := sender.ValidateForCreate(region); err != nil {
+		return nil, errors.Join(sender.NewErrSenderDoesNotValidForCreate(), err)
+	}
+
+	if err := sender.NormalizeForCreate(region); err != nil {
+		return nil, errors.Join(sender.NewErrSenderDoesNotValidForCreate(), err)
+	}
+
+	sender.TenantID = current.ID
+
+	err := s.repository.Create(ctx, &sender)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return nil, sender.NewErrSenderAlreadyExists()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+	}
+
+	return &sender, nil
+}
@@ -0,0 +1,36 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/sender"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	sender := sender.Sender{
+		ID: id,
+	}
+
+	if err := sender.ValidateForFind(); err != nil {
+		return errors.Join(sender.NewErrSenderDoesNotValidForFind(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	err := s.repository.Delete(ctx, current.ID, id)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return sender.NewErrSenderNotFound()
+	}
+	if err != nil {
+		return fmt.Errorf("service.repository.Delete(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/sender"
+	"messager/domain/tenant"
+)
+
+func (s *service) List(ctx context.Context) ([]sender.Sender, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	senders, err := s.repository.FindAll(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAll(): %w", err)
+	}
+
+	return senders, nil
+}
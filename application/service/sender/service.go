@@ -0,0 +1,21 @@
+package sender
+
+import (
+	"messager/domain/sender"
+)
+
+type Config struct {
+	DefaultRegion string
+}
+
+type service struct {
+	repository sender.Repository
+	config     *Config
+}
+
+func New(repository sender.Repository, config Config) sender.Service {
+	return &service{
+		repository: repository,
+		config:     &config,
+	}
+}
@@ -0,0 +1,30 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/domain/tenant"
+)
+
+func (s *service) Create(ctx context.Context, template template.Template) (*template.Template, error) {
+	if err := template.ValidateForCreate(); err != nil {
+		return nil, errors.Join(template.NewErrTemplateDoesNotValidForCreate(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	template.Normalize()
+	template.TenantID = current.ID
+
+	if err := s.repository.Create(ctx, &template); err != nil {
+		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+	}
+
+	return &template, nil
+}
@@ -0,0 +1,36 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	template := template.Template{
+		ID: id,
+	}
+
+	if err := template.ValidateForFind(); err != nil {
+		return errors.Join(template.NewErrTemplateDoesNotValidForFind(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	err := s.repository.Delete(ctx, current.ID, id)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return template.NewErrTemplateNotFound()
+	}
+	if err != nil {
+		return fmt.Errorf("service.repository.Delete(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Get(ctx context.Context, id string) (*template.Template, error) {
+	template := template.Template{
+		ID: id,
+	}
+
+	if err := template.ValidateForFind(); err != nil {
+		return nil, errors.Join(template.NewErrTemplateDoesNotValidForFind(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	foundTemplate, err := s.repository.FindByID(ctx, current.ID, id)
+	if foundTemplate == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return nil, template.NewErrTemplateNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindByID(): %w", err)
+	}
+
+	return foundTemplate, nil
+}
@@ -0,0 +1,23 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/domain/tenant"
+)
+
+func (s *service) List(ctx context.Context) ([]template.Template, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	templates, err := s.repository.FindAll(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAll(): %w", err)
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,42 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/message"
+	"messager/domain/template"
+	"messager/domain/tenant"
+)
+
+func (s *service) Preview(ctx context.Context, id, locale, phone string, variables map[string]string) (*template.Preview, error) {
+	foundTemplate, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	recipient := message.Message{
+		Phone: phone,
+	}
+
+	variant := foundTemplate.SelectVariant(locale, recipient.PhoneRegion(current.Region(s.config.DefaultRegion)))
+
+	content, err := variant.Render(variables)
+	if err != nil {
+		return nil, fmt.Errorf("template.Variant.Render(): %w", err)
+	}
+
+	encoding, segments := message.CountSegments(content)
+
+	return &template.Preview{
+		Locale:   variant.Locale,
+		Content:  content,
+		Encoding: encoding,
+		Segments: segments,
+	}, nil
+}
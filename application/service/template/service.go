@@ -0,0 +1,21 @@
+package template
+
+import (
+	"messager/domain/template"
+)
+
+type Config struct {
+	DefaultRegion string
+}
+
+type service struct {
+	repository template.Repository
+	config     *Config
+}
+
+func New(repository template.Repository, config Config) template.Service {
+	return &service{
+		repository: repository,
+		config:     &config,
+	}
+}
@@ -0,0 +1,35 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Update(ctx context.Context, template template.Template) (*template.Template, error) {
+	if err := template.ValidateForUpdate(); err != nil {
+		return nil, errors.Join(template.NewErrTemplateDoesNotValidForUpdate(), err)
+	}
+
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	template.Normalize()
+	template.TenantID = current.ID
+
+	err := s.repository.Update(ctx, &template)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return nil, template.NewErrTemplateNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.Update(): %w", err)
+	}
+
+	return &template, nil
+}
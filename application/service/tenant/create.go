@@ -0,0 +1,42 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+)
+
+func (s *service) Create(ctx context.Context, tenant tenant.Tenant) (*tenant.Tenant, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tenant.ValidateForCreate(); err != nil {
+		return nil, errors.Join(tenant.NewErrTenantDoesNotValidForCreate(), err)
+	}
+
+	tenant.NormalizeForCreate()
+
+	if err := s.repository.Create(ctx, &tenant); err != nil {
+		return nil, fmt.Errorf("service.repository.Create(): %w", err)
+	}
+
+	return &tenant, nil
+}
+
+// authorize allows only the default tenant, the operator of messager, to
+// manage tenants.
+func authorize(ctx context.Context) error {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	if !current.IsDefault() {
+		return current.NewErrTenantForbidden()
+	}
+
+	return nil
+}
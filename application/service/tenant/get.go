@@ -0,0 +1,55 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// Get returns a tenant to itself or to the default tenant. Other tenants are
+// reported as not found, so that tenants cannot find out about each other.
+func (s *service) Get(ctx context.Context, id string) (*tenant.Tenant, error) {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, current.NewErrTenantMissing()
+	}
+
+	if !current.IsDefault() && current.ID != id {
+		return nil, current.NewErrTenantNotFound()
+	}
+
+	return s.find(ctx, id)
+}
+
+// Resolve returns the tenant a request names, or the default tenant when it
+// names none.
+func (s *service) Resolve(ctx context.Context, id string) (*tenant.Tenant, error) {
+	if id == "" {
+		id = tenant.DefaultID
+	}
+
+	return s.find(ctx, id)
+}
+
+func (s *service) find(ctx context.Context, id string) (*tenant.Tenant, error) {
+	tenant := tenant.Tenant{
+		ID: id,
+	}
+
+	if err := tenant.ValidateForFind(); err != nil {
+		return nil, errors.Join(tenant.NewErrTenantDoesNotValidForFind(), err)
+	}
+
+	foundTenant, err := s.repository.FindByID(ctx, id)
+	if foundTenant == nil || errors.Is(err, postgresql.ErrNoRows) {
+		return nil, tenant.NewErrTenantNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindByID(): %w", err)
+	}
+
+	return foundTenant, nil
+}
@@ -0,0 +1,21 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/tenant"
+)
+
+func (s *service) List(ctx context.Context) ([]tenant.Tenant, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	tenants, err := s.repository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.FindAll(): %w", err)
+	}
+
+	return tenants, nil
+}
@@ -0,0 +1,37 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// Pause stops the dispatch of the messages of the tenant of ctx. Messages are
+// still accepted and are sent once the tenant is resumed.
+func (s *service) Pause(ctx context.Context) error {
+	return s.setPaused(ctx, true)
+}
+
+func (s *service) Resume(ctx context.Context) error {
+	return s.setPaused(ctx, false)
+}
+
+func (s *service) setPaused(ctx context.Context, paused bool) error {
+	current, ok := tenant.FromContext(ctx)
+	if !ok {
+		return current.NewErrTenantMissing()
+	}
+
+	err := s.repository.UpdatePaused(ctx, current.ID, paused)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return current.NewErrTenantNotFound()
+	}
+	if err != nil {
+		return fmt.Errorf("service.repository.UpdatePaused(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package tenant
+
+import (
+	"messager/domain/tenant"
+)
+
+type service struct {
+	repository tenant.Repository
+}
+
+func New(repository tenant.Repository) tenant.Service {
+	return &service{
+		repository: repository,
+	}
+}
@@ -0,0 +1,32 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+func (s *service) Update(ctx context.Context, tenant tenant.Tenant) (*tenant.Tenant, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tenant.ValidateForUpdate(); err != nil {
+		return nil, errors.Join(tenant.NewErrTenantDoesNotValidForUpdate(), err)
+	}
+
+	tenant.NormalizeForCreate()
+
+	err := s.repository.Update(ctx, &tenant)
+	if errors.Is(err, postgresql.ErrNoRows) {
+		return nil, tenant.NewErrTenantNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.repository.Update(): %w", err)
+	}
+
+	return &tenant, nil
+}
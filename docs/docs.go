@@ -15,22 +15,119 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/messages": {
+        "/api-keys": {
             "get": {
-                "description": "Get a list of messages filtered by their status",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the keys of the caller's tenant. Their secrets are not returned.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "api-keys"
+                ],
+                "summary": "List API keys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/apikey.listResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a key for the caller's tenant with one of the roles READ_ONLY, SENDER or ADMIN. The default tenant\nmay create keys for other tenants with tenantId. key is only returned here; only its hash is stored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Create an API key",
+                "parameters": [
+                    {
+                        "description": "API key to be created",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apikey.apiKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/apikey.apiKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tenant is not allowed to create keys for other tenants",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
-                "summary": "List messages by status",
+                "description": "Delete a key of the caller's tenant by ID. Requests with it are refused from then on.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Revoke an API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Message status (e.g., PENDING, SENT)",
-                        "name": "status",
-                        "in": "query",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -38,15 +135,52 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/message.listByStatusResponse"
+                            "$ref": "#/definitions/apikey.deleteResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid status parameter",
+                        "description": "Invalid API key ID",
                         "schema": {
                             "$ref": "#/definitions/server.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "API key not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all campaigns, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaigns"
+                ],
+                "summary": "List campaigns",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/campaign.listResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -56,7 +190,12 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new message with content and phone number",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a draft campaign that sends one content or template to many recipients. A message is created for\nevery valid recipient and dispatched once the campaign is started. Invalid recipients are reported as\nrejections. sendAt, timeZone, validUntil, priority (default BULK) and category (default MARKETING) apply to every message as in\nPOST /messages. Recipient variables override the campaign variables. The messages count against the daily\nquota of the caller's tenant.",
                 "consumes": [
                     "application/json"
                 ],
@@ -64,17 +203,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "campaigns"
                 ],
-                "summary": "Create a new message",
+                "summary": "Create a new campaign",
                 "parameters": [
                     {
-                        "description": "Message object to be created",
-                        "name": "message",
+                        "description": "Campaign object to be created",
+                        "name": "campaign",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/message.createRequest"
+                            "$ref": "#/definitions/campaign.createRequest"
                         }
                     }
                 ],
@@ -82,7 +221,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/message.createResponse"
+                            "$ref": "#/definitions/campaign.campaignResponse"
                         }
                     },
                     "400": {
@@ -91,6 +230,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/server.ErrorResponse"
                         }
                     },
+                    "429": {
+                        "description": "Tenant daily quota exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -100,21 +245,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/messages/jobs": {
-            "post": {
-                "description": "Starts the background job that sends pending messages",
+        "/campaigns/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a campaign by ID with the number of its messages in every status",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "campaigns"
+                ],
+                "summary": "Get a campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Start the message sending job",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/message.startJobResponse"
+                            "$ref": "#/definitions/campaign.campaignResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid campaign ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Campaign not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
                         }
                     },
                     "500": {
@@ -124,21 +295,55 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Stops the background job that sends pending messages",
+            }
+        },
+        "/campaigns/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel a campaign and its messages that are not being sent yet",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "campaigns"
+                ],
+                "summary": "Cancel a campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Stop the message sending job",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/message.stopJobResponse"
+                            "$ref": "#/definitions/campaign.campaignResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid campaign ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Campaign not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Campaign status does not allow cancelling",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
                         }
                     },
                     "500": {
@@ -149,100 +354,2803 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "message.createRequest": {
-            "type": "object",
-            "properties": {
-                "content": {
-                    "type": "string",
-                    "example": "Hello, world!"
-                },
-                "phone": {
-                    "type": "string",
-                    "example": "+905551234567"
-                }
-            }
-        },
-        "message.createResponse": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"
-                }
-            }
         },
-        "message.listByStatusResponse": {
-            "type": "object",
-            "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/message.listByStatusResponseItem"
+        "/campaigns/{id}/pause": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop dispatching the pending messages of a running campaign until it is started again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaigns"
+                ],
+                "summary": "Pause a campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/campaign.campaignResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid campaign ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Campaign not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Campaign status does not allow pausing",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "message.listByStatusResponseItem": {
-            "type": "object",
-            "properties": {
-                "content": {
-                    "type": "string",
-                    "example": "Hello from Swagger!"
-                },
-                "createdAt": {
-                    "type": "string",
-                    "example": "2023-10-27T10:00:00Z"
-                },
-                "id": {
-                    "type": "string",
+        "/campaigns/{id}/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Start dispatching the messages of a draft campaign, or resume a paused one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaigns"
+                ],
+                "summary": "Start a campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/campaign.campaignResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid campaign ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Campaign not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Campaign status does not allow starting",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/consents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the consent history of a phone, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consents"
+                ],
+                "summary": "List consents of a phone",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Phone number",
+                        "name": "phone",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/consent.listByPhoneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid phone",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record an opt-in or opt-out of a phone for a category (MARKETING by default). The latest record of a\nphone and category wins. Marketing messages to opted-out phones are refused at create and send time,\ntransactional messages are always allowed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consents"
+                ],
+                "summary": "Record a consent",
+                "parameters": [
+                    {
+                        "description": "Consent object to be recorded",
+                        "name": "consent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/consent.createRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/consent.consentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/content-policies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the content policies stored in the database. Policies from the configuration are not listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "content-policies"
+                ],
+                "summary": "List content policies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/policy.listResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a set of content rules every new message must satisfy: forbidden words (case insensitive,\nwhole words), forbidden regular expressions, domains links may point to, a footer content must end\nwith and a maximum number of links. Rules that are left empty are not enforced.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "content-policies"
+                ],
+                "summary": "Create a content policy",
+                "parameters": [
+                    {
+                        "description": "Content policy to be created",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/policy.policyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/policy.policyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/content-policies/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a content policy by ID. Messages already created are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "content-policies"
+                ],
+                "summary": "Delete a content policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Content policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/policy.deleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid content policy ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Content policy not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts the background job that claims the messages of every tenant for sending, if it is not running.\nOnly the default tenant, the operator of messager, may start it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Start the message job",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/message.startJobResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops the background job that claims the messages of every tenant for sending. Messages are still\naccepted and are sent once the job is started again. Only the default tenant, the operator of messager,\nmay stop it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Stop the message job",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/message.stopJobResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a page of messages, newest first, optionally filtered by status, phone, creation and update time,\ncontent, tag or metadata. Messages with the metadata key are listed regardless of its value unless\nmetadataValue is provided. Pass nextCursor of a page as cursor to get the next one, the last page has\nno nextCursor.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "List messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated message statuses (e.g., PENDING or SENT,DELIVERED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Phone the messages are sent to, in E.164 or local to the default region of the tenant",
+                        "name": "phone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the messages are created at or after",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the messages are created before",
+                        "name": "createdTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the messages are updated at or after",
+                        "name": "updatedFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the messages are updated before",
+                        "name": "updatedTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Words the content of the messages must contain",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag the messages must have",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Metadata key the messages must have",
+                        "name": "metadataKey",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Value of metadataKey the messages must have",
+                        "name": "metadataValue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of messages in the page, 50 by default and at most 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/message.listByStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new message with content and phone number. The message is sent immediately unless sendAt is provided.\nchannel is SMS (default) or EMAIL. Email messages take email and subject instead of phone, and an optional\nhtml alternative to content. Email is only accepted when an SMTP server is configured.\nmetadata (up to 20 keys) and tags (up to 20) are stored with the message and can be filtered on in\nGET /messages.\nfrom picks the sender of an SMS from the sender pool; when omitted, one is selected with SENDER_STRATEGY.\nsendAt is an RFC 3339 timestamp, or a local time (2006-01-02T15:04:05) when timeZone is provided.\ntimeZone is either an IANA time zone or \"recipient\" for the local time of the phone's region.\nvalidUntil is an RFC 3339 timestamp after which the message expires instead of being sent.\npriority is one of TRANSACTIONAL, NORMAL (default) or BULK.\ncategory is TRANSACTIONAL or MARKETING (default). Marketing messages to opted-out phones are refused.\ntemplateId and variables may be provided instead of content to render a template. The template variant is\nselected by locale, or by the phone's region when locale is omitted.\nAn Idempotency-Key header makes retries return the message created by the first request. Reusing a key\nwith a different request is rejected.\nMessages count against the daily quota of the caller's tenant.\nErrors are RFC 7807 problems with a stable code, and the invalid fields in errors. Content policy\nviolations are reported as errors of the content field.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Create a new message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key identifying the request across retries",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Message object to be created",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/message.createRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/message.createResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key reused or in progress",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Recipient opted out",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Tenant daily quota exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create up to MESSAGE_MAX_BATCH_SIZE messages in one request. Every item accepts the fields of POST /messages.\nValid items are created together, invalid items are reported with their index and error without failing\nthe batch. Failed items carry the code and invalid fields of their error, as problems do. The valid\nitems are refused together when they exceed the daily quota of the caller's tenant.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Create messages in batch",
+                "parameters": [
+                    {
+                        "description": "Messages to be created",
+                        "name": "messages",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/message.createBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/message.createBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Tenant daily quota exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/jobs": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Resumes sending the pending messages of the caller's tenant. The messages of other tenants are not\naffected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Start sending the messages of the tenant",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/message.startJobResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pauses sending the pending messages of the caller's tenant. Messages are still accepted and are sent once\nthe job is started again. The messages of other tenants are not affected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Stop sending the messages of the tenant",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/message.stopJobResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a message with its delivery attempts, in the order they were made. An attempt records the provider,\nthe ID the provider gave the message, the status code it replied with and the latency of the request.\nFailed attempts are classified as TEMPORARY when the message is retried, PERMANENT otherwise.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Get a message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/message.getResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid message ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Message not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages/{id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the status transition history of a message",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "List message events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/message.listEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid message ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Message not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/senders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the senders messages are sent from, in the order the COUNTRY strategy considers them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "senders"
+                ],
+                "summary": "List the sender pool",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/sender.listResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a phone number (NUMBER) or an alphanumeric sender ID of up to 11 characters (ALPHANUMERIC) to the\npool messages are sent from. A sender with countries is only used for recipients in them, others are\nused for every recipient. Numbers are normalized to E.164.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "senders"
+                ],
+                "summary": "Add a sender to the pool",
+                "parameters": [
+                    {
+                        "description": "Sender to be added",
+                        "name": "sender",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/sender.senderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/sender.senderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Sender already exists",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/senders/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a sender by ID. Messages already created keep the sender they were assigned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "senders"
+                ],
+                "summary": "Remove a sender from the pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sender ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/sender.deleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sender ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Sender not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all message templates",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "List templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.listResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a message template with one variant per locale. Variant content may contain {{name}} placeholders.\ndefaultLocale is the variant used when no other variant matches, and defaults to the first variant.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Create a new template",
+                "parameters": [
+                    {
+                        "description": "Template object to be created",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/template.templateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/template.templateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a message template by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Get a template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.templateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid template ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the name, default locale and variants of a message template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Update a template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template object to be updated",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/template.templateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.templateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a message template by ID. Messages already created from it are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Delete a template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.deleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid template ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{id}/preview": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Render a template with variables and return its content and segment count.\nThe variant is selected by locale, or by the phone's region when locale is omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Preview a template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variables and recipient to render the template for",
+                        "name": "preview",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/template.previewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.previewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get every tenant. Only the default tenant may list tenants.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "List tenants",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.listResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tenant is not allowed to manage tenants",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a tenant messages, templates, campaigns, senders, content policies and consents are scoped to.\nPhone numbers of the tenant are parsed in its default region and a daily quota of zero is unlimited.\nOnly the default tenant may create tenants.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Create a tenant",
+                "parameters": [
+                    {
+                        "description": "Tenant to be created",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tenant.tenantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.tenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tenant is not allowed to manage tenants",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a tenant by ID. Tenants other than the default one may only get themselves.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Get a tenant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.tenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tenant ID",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the name, default region and daily quota of a tenant. Only the default tenant may update\ntenants.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Update a tenant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tenant to be updated",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tenant.tenantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.tenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tenant is not allowed to manage tenants",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apikey.apiKeyRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "checkout"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "SENDER"
+                },
+                "tenantId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                }
+            }
+        },
+        "apikey.apiKeyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "msk_Zm9vYmFyYmF6cXV4Zm9vYmFyYmF6cXV4Zm9vYmFyYmE"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "checkout"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "msk_Zm9vYmFy"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "SENDER"
+                },
+                "tenantId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                }
+            }
+        },
+        "apikey.deleteResponse": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "apikey.listResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apikey.apiKeyResponse"
+                    }
+                }
+            }
+        },
+        "campaign.campaignResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "MARKETING"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Everything is 50% off today!"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Black Friday"
+                },
+                "priority": {
+                    "type": "string",
+                    "example": "BULK"
+                },
+                "progress": {
+                    "$ref": "#/definitions/campaign.progressResponse"
+                },
+                "recipientCount": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "rejections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/campaign.rejectionResponse"
+                    }
+                },
+                "sendAt": {
+                    "type": "string",
+                    "example": "2025-01-01T09:00:00"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "DRAFT"
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "timeZone": {
+                    "type": "string",
+                    "example": "recipient"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "validUntil": {
+                    "type": "string",
+                    "example": "2025-01-01T21:00:00Z"
+                }
+            }
+        },
+        "campaign.createRequest": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "MARKETING"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Everything is 50% off today!"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Black Friday"
+                },
+                "priority": {
+                    "type": "string",
+                    "example": "BULK"
+                },
+                "recipients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/campaign.recipientRequest"
+                    }
+                },
+                "sendAt": {
+                    "type": "string",
+                    "example": "2025-01-01T09:00:00"
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "timeZone": {
+                    "type": "string",
+                    "example": "recipient"
+                },
+                "validUntil": {
+                    "type": "string",
+                    "example": "2025-01-01T21:00:00Z"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "campaign.listResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/campaign.campaignResponse"
+                    }
+                }
+            }
+        },
+        "campaign.progressResponse": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "delivered": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "expired": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "pending": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "queued": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "sending": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "sent": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "campaign.recipientRequest": {
+            "type": "object",
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "campaign.rejectionResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "message does not valid for create\nmessage phone must be a valid phone number"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+90555"
+                }
+            }
+        },
+        "consent.consentResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "MARKETING"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "sms-reply:STOP"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "OPTED_OUT"
+                }
+            }
+        },
+        "consent.createRequest": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "MARKETING"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "sms-reply:STOP"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "OPTED_OUT"
+                }
+            }
+        },
+        "consent.listByPhoneResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/consent.consentResponse"
+                    }
+                }
+            }
+        },
+        "message.createBatchRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/message.createRequest"
+                    }
+                }
+            }
+        },
+        "message.createBatchResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/message.createBatchResponseItem"
+                    }
+                }
+            }
+        },
+        "message.createBatchResponseItem": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string",
+                    "example": "SMS"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "MESSAGE_INVALID_FOR_CREATE"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "encoding": {
+                    "type": "string",
+                    "example": "GSM-7"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "message does not valid for create\nmessage content must be provided"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.FieldErrorResponse"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "+905550000000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "segments": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "message.createRequest": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "TRANSACTIONAL"
+                },
+                "channel": {
+                    "type": "string",
+                    "example": "SMS"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Hello, world!"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "+905550000000"
+                },
+                "html": {
+                    "type": "string",
+                    "example": "\u003cp\u003eHello, world!\u003c/p\u003e"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "priority": {
+                    "type": "string",
+                    "example": "TRANSACTIONAL"
+                },
+                "sendAt": {
+                    "type": "string",
+                    "example": "2025-01-01T09:00:00"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Your order has shipped"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order-update"
+                    ]
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "timeZone": {
+                    "type": "string",
+                    "example": "recipient"
+                },
+                "validUntil": {
+                    "type": "string",
+                    "example": "2025-01-01T10:00:00Z"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "message.createResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string",
+                    "example": "SMS"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "encoding": {
+                    "type": "string",
+                    "example": "GSM-7"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "+905550000000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "segments": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "message.getResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/message.getResponseAttempt"
+                    }
+                },
+                "campaignId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "category": {
+                    "type": "string",
+                    "example": "MARKETING"
+                },
+                "channel": {
+                    "type": "string",
+                    "example": "SMS"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Hello from Swagger!"
+                },
+                "countryCode": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "encoding": {
+                    "type": "string",
+                    "example": "GSM-7"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "+905550000000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "priority": {
+                    "type": "string",
+                    "example": "NORMAL"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "TR"
+                },
+                "segments": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sendAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Your order has shipped"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order-update"
+                    ]
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "validUntil": {
+                    "type": "string",
+                    "example": "2023-10-27T11:00:00Z"
+                }
+            }
+        },
+        "message.getResponseAttempt": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "errorClass": {
+                    "type": "string",
+                    "example": "TEMPORARY"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "latencyMs": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "sms.example.com"
+                },
+                "providerMessageId": {
+                    "type": "string",
+                    "example": "67f2f8a8-ea58-4ed0-a6f9-ff217df4d849"
+                },
+                "responseStatus": {
+                    "type": "integer",
+                    "example": 202
+                },
+                "status": {
+                    "type": "string",
+                    "example": "SUCCEEDED"
+                }
+            }
+        },
+        "message.listByStatusResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/message.listByStatusResponseItem"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string",
+                    "example": "eyJjIjoiMjAyMy0xMC0yN1QxMDowMDowMFoiLCJpIjoiYTFiMmMzZDQifQ"
+                }
+            }
+        },
+        "message.listByStatusResponseItem": {
+            "type": "object",
+            "properties": {
+                "campaignId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "category": {
+                    "type": "string",
+                    "example": "MARKETING"
+                },
+                "channel": {
+                    "type": "string",
+                    "example": "SMS"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Hello from Swagger!"
+                },
+                "countryCode": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "encoding": {
+                    "type": "string",
+                    "example": "GSM-7"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "+905550000000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "priority": {
+                    "type": "string",
+                    "example": "NORMAL"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "TR"
+                },
+                "segments": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sendAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Your order has shipped"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order-update"
+                    ]
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "validUntil": {
+                    "type": "string",
+                    "example": "2023-10-27T11:00:00Z"
+                }
+            }
+        },
+        "message.listEventsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/message.listEventsResponseItem"
+                    }
+                }
+            }
+        },
+        "message.listEventsResponseItem": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "id": {
+                    "type": "string",
                     "example": "a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"
                 },
-                "phone": {
+                "to": {
+                    "type": "string",
+                    "example": "SENDING"
+                }
+            }
+        },
+        "message.startJobResponse": {
+            "type": "object",
+            "properties": {
+                "started": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "message.stopJobResponse": {
+            "type": "object",
+            "properties": {
+                "stopped": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "policy.deleteResponse": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "policy.listResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/policy.policyResponse"
+                    }
+                }
+            }
+        },
+        "policy.policyRequest": {
+            "type": "object",
+            "properties": {
+                "allowedDomains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "example.com"
+                    ]
+                },
+                "forbiddenPatterns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "(?i)guaranteed\\s+win"
+                    ]
+                },
+                "forbiddenWords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "free money"
+                    ]
+                },
+                "maxLinks": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "marketing"
+                },
+                "requiredFooter": {
+                    "type": "string",
+                    "example": "Reply STOP to opt out."
+                }
+            }
+        },
+        "policy.policyResponse": {
+            "type": "object",
+            "properties": {
+                "allowedDomains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "example.com"
+                    ]
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "forbiddenPatterns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "(?i)guaranteed\\s+win"
+                    ]
+                },
+                "forbiddenWords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "free money"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "maxLinks": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "marketing"
+                },
+                "requiredFooter": {
+                    "type": "string",
+                    "example": "Reply STOP to opt out."
+                }
+            }
+        },
+        "sender.deleteResponse": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "sender.listResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/sender.senderResponse"
+                    }
+                }
+            }
+        },
+        "sender.senderRequest": {
+            "type": "object",
+            "properties": {
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "TR"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "example": "NUMBER"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "+905551234567"
+                }
+            }
+        },
+        "sender.senderResponse": {
+            "type": "object",
+            "properties": {
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "TR"
+                    ]
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "NUMBER"
+                },
+                "value": {
                     "type": "string",
                     "example": "+905551234567"
+                }
+            }
+        },
+        "server.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "MESSAGE_INVALID_FOR_CREATE"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "message does not valid for create\nmessage content must be provided"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.FieldErrorResponse"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/messages"
                 },
                 "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
                     "type": "string",
-                    "example": "PENDING"
+                    "example": "Invalid request."
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
+        "server.FieldErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "REQUIRED"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "message content must be provided"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "content"
+                },
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "template.deleteResponse": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "template.listResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/template.templateResponse"
+                    }
+                }
+            }
+        },
+        "template.previewRequest": {
+            "type": "object",
+            "properties": {
+                "locale": {
+                    "type": "string",
+                    "example": "tr"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+905551234567"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "template.previewResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Your code is 123456."
+                },
+                "encoding": {
+                    "type": "string",
+                    "example": "GSM-7"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "segments": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "template.templateRequest": {
+            "type": "object",
+            "properties": {
+                "defaultLocale": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "otp"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/template.variantRequest"
+                    }
+                }
+            }
+        },
+        "template.templateResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "defaultLocale": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "otp"
                 },
                 "updatedAt": {
                     "type": "string",
                     "example": "2023-10-27T10:00:00Z"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/template.variantResponse"
+                    }
                 }
             }
         },
-        "message.startJobResponse": {
+        "template.variantRequest": {
             "type": "object",
             "properties": {
-                "started": {
-                    "type": "boolean"
+                "content": {
+                    "type": "string",
+                    "example": "Your code is {{code}}."
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "en"
                 }
             }
         },
-        "message.stopJobResponse": {
+        "template.variantResponse": {
             "type": "object",
             "properties": {
-                "stopped": {
-                    "type": "boolean"
+                "content": {
+                    "type": "string",
+                    "example": "Your code is {{code}}."
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "variables": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "code"
+                    ]
                 }
             }
         },
-        "server.ErrorResponse": {
+        "tenant.listResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tenant.tenantResponse"
+                    }
+                }
+            }
+        },
+        "tenant.tenantRequest": {
+            "type": "object",
+            "properties": {
+                "dailyQuota": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "defaultRegion": {
                     "type": "string",
-                    "example": "details of the error"
+                    "example": "TR"
                 },
-                "message": {
+                "name": {
                     "type": "string",
-                    "example": "Invalid request."
+                    "example": "Shop"
+                }
+            }
+        },
+        "tenant.tenantResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
+                },
+                "dailyQuota": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "defaultRegion": {
+                    "type": "string",
+                    "example": "TR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Shop"
+                },
+                "paused": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-27T10:00:00Z"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "An API key, as \"Bearer msk_...\".",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
@@ -0,0 +1,147 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"messager/domain/fault"
+)
+
+const (
+	RoleReadOnly Role = "READ_ONLY"
+	RoleSender   Role = "SENDER"
+	RoleAdmin    Role = "ADMIN"
+
+	secretPrefix  = "msk_"
+	secretBytes   = 32
+	prefixLength  = len(secretPrefix) + 8
+	maxNameLength = 100
+)
+
+var (
+	ErrAPIKeyDoesNotValidForCreate = fault.New("API_KEY_INVALID_FOR_CREATE", "api key does not valid for create")
+	ErrAPIKeyDoesNotValidForFind   = fault.New("API_KEY_INVALID_FOR_FIND", "api key does not valid for find")
+	ErrAPIKeyNotFound              = fault.New("API_KEY_NOT_FOUND", "api key not found")
+	ErrAPIKeyUnauthenticated       = fault.New("API_KEY_UNAUTHENTICATED", "api key is missing or invalid")
+)
+
+// APIKey authenticates the requests of a tenant with a role. Only the hash of
+// its secret is stored, the secret itself is known once, when the key is
+// created.
+type APIKey struct {
+	ID        string
+	TenantID  string
+	CreatedAt time.Time
+	Name      string
+	Role      Role
+	Prefix    string
+	Hash      string
+	Secret    string
+}
+
+// Role is what the requests of a key are allowed to do. READ_ONLY reads,
+// SENDER also sends messages and campaigns, ADMIN also manages the
+// configuration of its tenant.
+type Role string
+
+func (k *APIKey) NewErrAPIKeyDoesNotValidForCreate() error {
+	return ErrAPIKeyDoesNotValidForCreate
+}
+
+func (k *APIKey) NewErrAPIKeyDoesNotValidForFind() error {
+	return ErrAPIKeyDoesNotValidForFind
+}
+
+func (k *APIKey) NewErrAPIKeyNotFound() error {
+	return ErrAPIKeyNotFound
+}
+
+func (k *APIKey) NewErrAPIKeyUnauthenticated() error {
+	return ErrAPIKeyUnauthenticated
+}
+
+func (k *APIKey) ValidateForCreate() error {
+	if k.Name == "" {
+		return fault.NewFieldError("name", fault.CodeRequired, "api key name must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(k.Name) > maxNameLength {
+		return fault.NewFieldError("name", fault.CodeTooLong,
+			fmt.Sprintf("api key name must not exceed %d characters", maxNameLength),
+			map[string]any{"max": maxNameLength})
+	}
+
+	if strings.TrimSpace(k.Name) != k.Name {
+		return fault.NewFieldError("name", fault.CodeWhitespace, "api key name must not contain leading or trailing whitespace", nil)
+	}
+
+	if k.Role == "" {
+		return fault.NewFieldError("role", fault.CodeRequired, "api key role must be provided", nil)
+	}
+
+	if !k.Role.IsValid() {
+		return fault.NewFieldError("role", fault.CodeNotOneOf, "api key role must be one of READ_ONLY, SENDER or ADMIN",
+			map[string]any{"allowed": []Role{RoleReadOnly, RoleSender, RoleAdmin}})
+	}
+
+	if k.TenantID != "" {
+		if err := uuid.Validate(k.TenantID); err != nil {
+			return fault.NewFieldError("tenantId", fault.CodeInvalid, fmt.Sprintf("api key tenant id must be a valid uuid: %v", err), nil)
+		}
+	}
+
+	return nil
+}
+
+func (k *APIKey) ValidateForFind() error {
+	if k.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "api key id must be provided", nil)
+	}
+
+	if err := uuid.Validate(k.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("api key id must be a valid uuid: %v", err), nil)
+	}
+
+	return nil
+}
+
+// Generate gives the key a random secret, and the prefix and hash of it that
+// are stored in its place.
+func (k *APIKey) Generate() error {
+	random := make([]byte, secretBytes)
+
+	if _, err := rand.Read(random); err != nil {
+		return fmt.Errorf("rand.Read(): %w", err)
+	}
+
+	k.Secret = secretPrefix + base64.RawURLEncoding.EncodeToString(random)
+	k.Prefix = k.Secret[:prefixLength]
+	k.Hash = Hash(k.Secret)
+
+	return nil
+}
+
+// Hash returns the hash keys are stored and found by. Secrets are random, so
+// a fast hash without a salt is enough to keep them from being recovered.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleReadOnly, RoleSender, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
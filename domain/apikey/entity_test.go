@@ -0,0 +1,97 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+)
+
+func TestAPIKey_ValidateForCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     APIKey
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid",
+			key:     APIKey{Name: "checkout", Role: RoleSender},
+			wantErr: false,
+		},
+		{
+			name:    "valid for another tenant",
+			key:     APIKey{Name: "checkout", Role: RoleAdmin, TenantID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d"},
+			wantErr: false,
+		},
+		{
+			name:    "empty name",
+			key:     APIKey{Role: RoleSender},
+			wantErr: true,
+			errMsg:  "api key name must be provided",
+		},
+		{
+			name:    "name too long",
+			key:     APIKey{Name: strings.Repeat("a", maxNameLength+1), Role: RoleSender},
+			wantErr: true,
+			errMsg:  "api key name must not exceed 100 characters",
+		},
+		{
+			name:    "name with whitespace",
+			key:     APIKey{Name: "checkout ", Role: RoleSender},
+			wantErr: true,
+			errMsg:  "api key name must not contain leading or trailing whitespace",
+		},
+		{
+			name:    "empty role",
+			key:     APIKey{Name: "checkout"},
+			wantErr: true,
+			errMsg:  "api key role must be provided",
+		},
+		{
+			name:    "invalid role",
+			key:     APIKey{Name: "checkout", Role: "OWNER"},
+			wantErr: true,
+			errMsg:  "api key role must be one of READ_ONLY, SENDER or ADMIN",
+		},
+		{
+			name:    "invalid tenant",
+			key:     APIKey{Name: "checkout", Role: RoleSender, TenantID: "invalid"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.key.ValidateForCreate()
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			var fieldErr *fault.FieldError
+
+			assert.ErrorAs(t, err, &fieldErr)
+
+			if tt.errMsg != "" {
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestAPIKey_Generate(t *testing.T) {
+	var key, other APIKey
+
+	assert.NoError(t, key.Generate())
+	assert.NoError(t, other.Generate())
+
+	assert.True(t, strings.HasPrefix(key.Secret, secretPrefix))
+	assert.True(t, strings.HasPrefix(key.Secret, key.Prefix))
+	assert.Len(t, key.Prefix, prefixLength)
+	assert.Equal(t, Hash(key.Secret), key.Hash)
+	assert.NotEqual(t, key.Secret, other.Secret)
+	assert.NotEqual(t, key.Hash, other.Hash)
+}
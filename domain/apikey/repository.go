@@ -0,0 +1,12 @@
+package apikey
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, key *APIKey) error
+	FindAll(ctx context.Context, tenantID string) ([]APIKey, error)
+	FindByHash(ctx context.Context, hash string) (*APIKey, error)
+	Delete(ctx context.Context, tenantID, id string) error
+}
@@ -0,0 +1,10 @@
+package apikey
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, key APIKey) (*APIKey, error)
+	List(ctx context.Context) ([]APIKey, error)
+	Delete(ctx context.Context, id string) error
+	Authenticate(ctx context.Context, secret string) (*APIKey, error)
+}
@@ -0,0 +1,284 @@
+package campaign
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"messager/domain/fault"
+	"messager/domain/message"
+)
+
+const (
+	StatusDraft     Status = "DRAFT"
+	StatusRunning   Status = "RUNNING"
+	StatusPaused    Status = "PAUSED"
+	StatusCancelled Status = "CANCELLED"
+
+	maxNameLength = 255
+)
+
+var (
+	ErrCampaignDoesNotValidForCreate      = fault.New("CAMPAIGN_INVALID_FOR_CREATE", "campaign does not valid for create")
+	ErrCampaignDoesNotValidForFind        = fault.New("CAMPAIGN_INVALID_FOR_FIND", "campaign does not valid for find")
+	ErrCampaignNotFound                   = fault.New("CAMPAIGN_NOT_FOUND", "campaign not found")
+	ErrCampaignStatusTransitionNotAllowed = fault.New("CAMPAIGN_STATUS_TRANSITION_NOT_ALLOWED", "campaign status transition not allowed")
+)
+
+var transitions = map[Status][]Status{
+	StatusDraft:   {StatusRunning, StatusCancelled},
+	StatusRunning: {StatusPaused, StatusCancelled},
+	StatusPaused:  {StatusRunning, StatusCancelled},
+}
+
+// Campaign fans one content or template out to many recipients. Each
+// recipient becomes a message linked to the campaign, which is dispatched
+// only while the campaign is running.
+type Campaign struct {
+	ID             string
+	TenantID       string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Name           string
+	Status         Status
+	Content        string
+	TemplateID     string
+	Locale         string
+	Variables      map[string]string
+	SendAt         time.Time
+	TimeZone       string
+	ValidUntil     time.Time
+	Priority       message.Priority
+	Category       message.Category
+	Recipients     []Recipient
+	RecipientCount int
+	Rejections     []Rejection
+	Progress       Progress
+}
+
+type Recipient struct {
+	Phone     string
+	Variables map[string]string
+}
+
+// Rejection is a recipient whose message did not pass validation.
+type Rejection struct {
+	Index int
+	Phone string
+	Err   error
+}
+
+// Progress counts the messages of a campaign by status.
+type Progress map[message.Status]int
+
+type Status string
+
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+func (c *Campaign) NewErrCampaignDoesNotValidForCreate() error {
+	return ErrCampaignDoesNotValidForCreate
+}
+
+func (c *Campaign) NewErrCampaignDoesNotValidForFind() error {
+	return ErrCampaignDoesNotValidForFind
+}
+
+func (c *Campaign) NewErrCampaignNotFound() error {
+	return ErrCampaignNotFound
+}
+
+func (c *Campaign) NewErrCampaignStatusTransitionNotAllowed(to Status) error {
+	return &TransitionError{
+		From: c.Status,
+		To:   to,
+	}
+}
+
+func (c *Campaign) CanTransitionTo(status Status) bool {
+	for _, allowed := range transitions[c.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Campaign) TransitionTo(status Status) error {
+	if !c.CanTransitionTo(status) {
+		return c.NewErrCampaignStatusTransitionNotAllowed(status)
+	}
+
+	c.Status = status
+
+	return nil
+}
+
+func (c *Campaign) ValidateForCreate(maxRecipients int) error {
+	if c.Name == "" {
+		return fault.NewFieldError("name", fault.CodeRequired, "campaign name must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(c.Name) > maxNameLength {
+		return fault.NewFieldError("name", fault.CodeTooLong,
+			fmt.Sprintf("campaign name must not exceed %d characters", maxNameLength),
+			map[string]any{"max": maxNameLength})
+	}
+
+	if strings.TrimSpace(c.Name) != c.Name {
+		return fault.NewFieldError("name", fault.CodeWhitespace, "campaign name must not contain leading or trailing whitespace", nil)
+	}
+
+	if c.Content == "" && c.TemplateID == "" {
+		return fault.NewFieldError("content", fault.CodeRequired, "campaign content or template id must be provided", nil)
+	}
+
+	if c.Content != "" && c.TemplateID != "" {
+		return fault.NewFieldError("content", fault.CodeNotAllowed, "campaign content must not be provided with template id", nil)
+	}
+
+	if c.TemplateID != "" {
+		if err := uuid.Validate(c.TemplateID); err != nil {
+			return fault.NewFieldError("templateId", fault.CodeInvalid, fmt.Sprintf("campaign template id must be a valid uuid: %v", err), nil)
+		}
+	}
+
+	if c.Priority != "" && !c.Priority.IsValid() {
+		return fault.NewFieldError("priority", fault.CodeNotOneOf, "campaign priority must be one of TRANSACTIONAL, NORMAL or BULK",
+			map[string]any{"allowed": message.Priorities})
+	}
+
+	if c.Category != "" && !c.Category.IsValid() {
+		return fault.NewFieldError("category", fault.CodeNotOneOf, "campaign category must be one of TRANSACTIONAL or MARKETING",
+			map[string]any{"allowed": []message.Category{message.CategoryTransactional, message.CategoryMarketing}})
+	}
+
+	if len(c.Recipients) == 0 {
+		return fault.NewFieldError("recipients", fault.CodeRequired, "campaign must have at least one recipient", nil)
+	}
+
+	if len(c.Recipients) > maxRecipients {
+		return fault.NewFieldError("recipients", fault.CodeTooLong,
+			fmt.Sprintf("campaign must not exceed %d recipients", maxRecipients),
+			map[string]any{"max": maxRecipients})
+	}
+
+	for i, recipient := range c.Recipients {
+		if recipient.Phone == "" {
+			return fault.NewFieldError("recipients", fault.CodeRequired,
+				fmt.Sprintf("campaign recipient %d phone must be provided", i),
+				map[string]any{"index": i})
+		}
+	}
+
+	return nil
+}
+
+// NormalizeForCreate prepares a campaign validated by ValidateForCreate to be
+// persisted as a draft.
+func (c *Campaign) NormalizeForCreate() {
+	c.Status = StatusDraft
+	c.RecipientCount = len(c.Recipients)
+
+	if c.Priority == "" {
+		c.Priority = message.PriorityBulk
+	}
+
+	if c.Category == "" {
+		c.Category = message.CategoryMarketing
+	}
+
+	if !c.SendAt.IsZero() && c.TimeZone == "" {
+		c.SendAt = c.SendAt.UTC()
+	}
+
+	if !c.ValidUntil.IsZero() {
+		c.ValidUntil = c.ValidUntil.UTC()
+	}
+}
+
+func (c *Campaign) ValidateForFind() error {
+	if c.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "campaign id must be provided", nil)
+	}
+
+	if err := uuid.Validate(c.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("campaign id must be a valid uuid: %v", err), nil)
+	}
+
+	return nil
+}
+
+// Messages expands the campaign into one pending message per recipient.
+// Recipient variables override the variables of the campaign.
+func (c *Campaign) Messages() []message.Message {
+	messages := make([]message.Message, 0, len(c.Recipients))
+
+	for _, recipient := range c.Recipients {
+		var variables map[string]string
+
+		if c.TemplateID != "" {
+			variables = maps.Clone(c.Variables)
+			if variables == nil {
+				variables = make(map[string]string, len(recipient.Variables))
+			}
+
+			maps.Copy(variables, recipient.Variables)
+		}
+
+		messages = append(messages, message.Message{
+			Content:    c.Content,
+			Phone:      recipient.Phone,
+			Status:     message.StatusPending,
+			SendAt:     c.SendAt,
+			TimeZone:   c.TimeZone,
+			ValidUntil: c.ValidUntil,
+			Priority:   c.Priority,
+			Category:   c.Category,
+			TemplateID: c.TemplateID,
+			Locale:     c.Locale,
+			Variables:  variables,
+			CampaignID: c.ID,
+		})
+	}
+
+	return messages
+}
+
+func (p Progress) Total() int {
+	total := 0
+
+	for _, count := range p {
+		total += count
+	}
+
+	return total
+}
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusDraft, StatusRunning, StatusPaused, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("campaign status cannot transition from %s to %s", e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrCampaignStatusTransitionNotAllowed
+}
+
+func (e *TransitionError) Code() string {
+	return ErrCampaignStatusTransitionNotAllowed.Code()
+}
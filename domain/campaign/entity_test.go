@@ -0,0 +1,140 @@
+package campaign
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+	"messager/domain/message"
+)
+
+func TestCampaign_ValidateForCreate(t *testing.T) {
+	recipients := []Recipient{{Phone: "+905551234567"}}
+
+	tests := []struct {
+		name     string
+		campaign Campaign
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "valid campaign",
+			campaign: Campaign{Name: "Black Friday", Content: "Everything is 50% off today!", Recipients: recipients},
+			wantErr:  false,
+		},
+		{
+			name:     "valid template campaign",
+			campaign: Campaign{Name: "Black Friday", TemplateID: uuid.New().String(), Recipients: recipients},
+			wantErr:  false,
+		},
+		{
+			name:     "empty name",
+			campaign: Campaign{Content: "Everything is 50% off today!", Recipients: recipients},
+			wantErr:  true,
+			errMsg:   "campaign name must be provided",
+		},
+		{
+			name:     "no content or template",
+			campaign: Campaign{Name: "Black Friday", Recipients: recipients},
+			wantErr:  true,
+			errMsg:   "campaign content or template id must be provided",
+		},
+		{
+			name:     "content and template",
+			campaign: Campaign{Name: "Black Friday", Content: "Everything is 50% off today!", TemplateID: uuid.New().String(), Recipients: recipients},
+			wantErr:  true,
+			errMsg:   "campaign content must not be provided with template id",
+		},
+		{
+			name:     "invalid priority",
+			campaign: Campaign{Name: "Black Friday", Content: "Everything is 50% off today!", Priority: "URGENT", Recipients: recipients},
+			wantErr:  true,
+			errMsg:   "campaign priority must be one of TRANSACTIONAL, NORMAL or BULK",
+		},
+		{
+			name:     "no recipients",
+			campaign: Campaign{Name: "Black Friday", Content: "Everything is 50% off today!"},
+			wantErr:  true,
+			errMsg:   "campaign must have at least one recipient",
+		},
+		{
+			name:     "too many recipients",
+			campaign: Campaign{Name: "Black Friday", Content: "Everything is 50% off today!", Recipients: make([]Recipient, 3)},
+			wantErr:  true,
+			errMsg:   "campaign must not exceed 2 recipients",
+		},
+		{
+			name:     "recipient without phone",
+			campaign: Campaign{Name: "Black Friday", Content: "Everything is 50% off today!", Recipients: []Recipient{{Phone: "+905551234567"}, {}}},
+			wantErr:  true,
+			errMsg:   "campaign recipient 1 phone must be provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.campaign.ValidateForCreate(2)
+			if tt.wantErr {
+				var fieldErr *fault.FieldError
+
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCampaign_TransitionTo(t *testing.T) {
+	tests := []struct {
+		from    Status
+		to      Status
+		wantErr bool
+	}{
+		{from: StatusDraft, to: StatusRunning},
+		{from: StatusDraft, to: StatusPaused, wantErr: true},
+		{from: StatusRunning, to: StatusPaused},
+		{from: StatusPaused, to: StatusRunning},
+		{from: StatusPaused, to: StatusCancelled},
+		{from: StatusCancelled, to: StatusRunning, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			campaign := Campaign{Status: tt.from}
+			err := campaign.TransitionTo(tt.to)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrCampaignStatusTransitionNotAllowed)
+				assert.Equal(t, tt.from, campaign.Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.to, campaign.Status)
+			}
+		})
+	}
+}
+
+func TestCampaign_Messages(t *testing.T) {
+	campaign := Campaign{
+		ID:         uuid.New().String(),
+		TemplateID: uuid.New().String(),
+		Variables:  map[string]string{"discount": "50%", "name": "customer"},
+		Priority:   message.PriorityBulk,
+		Recipients: []Recipient{
+			{Phone: "+905551234567", Variables: map[string]string{"name": "Ada"}},
+			{Phone: "+905551234568"},
+		},
+	}
+
+	messages := campaign.Messages()
+
+	assert.Len(t, messages, 2)
+	assert.Equal(t, map[string]string{"discount": "50%", "name": "Ada"}, messages[0].Variables)
+	assert.Equal(t, map[string]string{"discount": "50%", "name": "customer"}, messages[1].Variables)
+	assert.Equal(t, campaign.ID, messages[1].CampaignID)
+	assert.Equal(t, message.StatusPending, messages[1].Status)
+	assert.Equal(t, message.PriorityBulk, messages[1].Priority)
+}
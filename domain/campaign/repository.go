@@ -0,0 +1,15 @@
+package campaign
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, campaign *Campaign) error
+	FindAll(ctx context.Context, tenantID string) ([]Campaign, error)
+	FindByID(ctx context.Context, tenantID, id string) (*Campaign, error)
+	FindProgressByID(ctx context.Context, tenantID, id string) (Progress, error)
+	UpdateStatus(ctx context.Context, tenantID, id string, from, to Status) error
+	Cancel(ctx context.Context, tenantID, id string, from Status) error
+	Delete(ctx context.Context, tenantID, id string) error
+}
@@ -0,0 +1,12 @@
+package campaign
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, campaign Campaign) (*Campaign, error)
+	List(ctx context.Context) ([]Campaign, error)
+	Get(ctx context.Context, id string) (*Campaign, error)
+	Start(ctx context.Context, id string) (*Campaign, error)
+	Pause(ctx context.Context, id string) (*Campaign, error)
+	Cancel(ctx context.Context, id string) (*Campaign, error)
+}
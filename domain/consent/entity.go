@@ -0,0 +1,133 @@
+package consent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nyaruka/phonenumbers"
+
+	"messager/domain/fault"
+	"messager/domain/message"
+)
+
+const (
+	StatusOptedIn  Status = "OPTED_IN"
+	StatusOptedOut Status = "OPTED_OUT"
+
+	maxSourceLength = 255
+)
+
+var (
+	ErrConsentDoesNotValidForCreate      = fault.New("CONSENT_INVALID_FOR_CREATE", "consent does not valid for create")
+	ErrConsentDoesNotValidForListByPhone = fault.New("CONSENT_INVALID_FOR_LIST_BY_PHONE", "consent does not valid for list by phone")
+)
+
+// Consent is an entry of the consent ledger. Entries are never updated, the
+// latest entry of a phone and category is its current status.
+type Consent struct {
+	ID        string
+	TenantID  string
+	CreatedAt time.Time
+	Phone     string
+	Status    Status
+	Source    string
+	Category  message.Category
+}
+
+type Status string
+
+func (c *Consent) NewErrConsentDoesNotValidForCreate() error {
+	return ErrConsentDoesNotValidForCreate
+}
+
+func (c *Consent) NewErrConsentDoesNotValidForListByPhone() error {
+	return ErrConsentDoesNotValidForListByPhone
+}
+
+func (c *Consent) ValidateForCreate(defaultRegion string) error {
+	if err := c.validatePhone(defaultRegion); err != nil {
+		return err
+	}
+
+	if c.Status == "" {
+		return fault.NewFieldError("status", fault.CodeRequired, "consent status must be provided", nil)
+	}
+
+	if !c.Status.IsValid() {
+		return fault.NewFieldError("status", fault.CodeNotOneOf, "consent status must be one of OPTED_IN or OPTED_OUT",
+			map[string]any{"allowed": []Status{StatusOptedIn, StatusOptedOut}})
+	}
+
+	if c.Source == "" {
+		return fault.NewFieldError("source", fault.CodeRequired, "consent source must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(c.Source) > maxSourceLength {
+		return fault.NewFieldError("source", fault.CodeTooLong,
+			fmt.Sprintf("consent source must not exceed %d characters", maxSourceLength),
+			map[string]any{"max": maxSourceLength})
+	}
+
+	if c.Category != "" && !c.Category.IsValid() {
+		return fault.NewFieldError("category", fault.CodeNotOneOf, "consent category must be one of TRANSACTIONAL or MARKETING",
+			map[string]any{"allowed": []message.Category{message.CategoryTransactional, message.CategoryMarketing}})
+	}
+
+	return nil
+}
+
+func (c *Consent) ValidateForListByPhone(defaultRegion string) error {
+	return c.validatePhone(defaultRegion)
+}
+
+// NormalizePhone formats the phone in E.164 so that it matches the phone of
+// the messages sent to it.
+func (c *Consent) NormalizePhone(defaultRegion string) error {
+	number, err := phonenumbers.Parse(c.Phone, defaultRegion)
+	if err != nil {
+		return fmt.Errorf("phonenumbers.Parse(): %w", err)
+	}
+
+	c.Phone = phonenumbers.Format(number, phonenumbers.E164)
+
+	return nil
+}
+
+func (c *Consent) NormalizeForCreate(defaultRegion string) error {
+	if err := c.NormalizePhone(defaultRegion); err != nil {
+		return err
+	}
+
+	if c.Category == "" {
+		c.Category = message.CategoryMarketing
+	}
+
+	return nil
+}
+
+func (c *Consent) validatePhone(defaultRegion string) error {
+	if c.Phone == "" {
+		return fault.NewFieldError("phone", fault.CodeRequired, "consent phone must be provided", nil)
+	}
+
+	if strings.TrimSpace(c.Phone) != c.Phone {
+		return fault.NewFieldError("phone", fault.CodeWhitespace, "consent phone must not contain leading or trailing whitespace", nil)
+	}
+
+	if number, err := phonenumbers.Parse(c.Phone, defaultRegion); err != nil || !phonenumbers.IsValidNumber(number) {
+		return fault.NewFieldError("phone", fault.CodeInvalid, "consent phone must be a valid phone number", nil)
+	}
+
+	return nil
+}
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusOptedIn, StatusOptedOut:
+		return true
+	default:
+		return false
+	}
+}
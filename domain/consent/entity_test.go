@@ -0,0 +1,83 @@
+package consent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+	"messager/domain/message"
+)
+
+func TestConsent_ValidateForCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		consent Consent
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid consent",
+			consent: Consent{Phone: "+905551234567", Status: StatusOptedOut, Source: "sms-reply:STOP"},
+			wantErr: false,
+		},
+		{
+			name:    "national phone",
+			consent: Consent{Phone: "05551234567", Status: StatusOptedIn, Source: "web-form"},
+			wantErr: false,
+		},
+		{
+			name:    "empty phone",
+			consent: Consent{Status: StatusOptedOut, Source: "sms-reply:STOP"},
+			wantErr: true,
+			errMsg:  "consent phone must be provided",
+		},
+		{
+			name:    "invalid phone",
+			consent: Consent{Phone: "12345", Status: StatusOptedOut, Source: "sms-reply:STOP"},
+			wantErr: true,
+			errMsg:  "consent phone must be a valid phone number",
+		},
+		{
+			name:    "invalid status",
+			consent: Consent{Phone: "+905551234567", Status: "MAYBE", Source: "sms-reply:STOP"},
+			wantErr: true,
+			errMsg:  "consent status must be one of OPTED_IN or OPTED_OUT",
+		},
+		{
+			name:    "empty source",
+			consent: Consent{Phone: "+905551234567", Status: StatusOptedOut},
+			wantErr: true,
+			errMsg:  "consent source must be provided",
+		},
+		{
+			name:    "invalid category",
+			consent: Consent{Phone: "+905551234567", Status: StatusOptedOut, Source: "sms-reply:STOP", Category: "PROMO"},
+			wantErr: true,
+			errMsg:  "consent category must be one of TRANSACTIONAL or MARKETING",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.consent.ValidateForCreate("TR")
+			if tt.wantErr {
+				var fieldErr *fault.FieldError
+
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConsent_NormalizeForCreate(t *testing.T) {
+	consent := Consent{Phone: "0555 123 45 67", Status: StatusOptedOut, Source: "sms-reply:STOP"}
+
+	err := consent.NormalizeForCreate("TR")
+	assert.NoError(t, err)
+	assert.Equal(t, "+905551234567", consent.Phone)
+	assert.Equal(t, message.CategoryMarketing, consent.Category)
+}
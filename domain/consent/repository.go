@@ -0,0 +1,13 @@
+package consent
+
+import (
+	"context"
+
+	"messager/domain/message"
+)
+
+type Repository interface {
+	Create(ctx context.Context, consent *Consent) error
+	FindAllByPhone(ctx context.Context, tenantID, phone string) ([]Consent, error)
+	FindAllOptedOutPhones(ctx context.Context, tenantID string, phones []string, category message.Category) ([]string, error)
+}
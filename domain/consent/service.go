@@ -0,0 +1,8 @@
+package consent
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, consent Consent) (*Consent, error)
+	ListByPhone(ctx context.Context, phone string) ([]Consent, error)
+}
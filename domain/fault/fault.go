@@ -0,0 +1,25 @@
+// Package fault provides domain errors with stable codes, so that clients can
+// tell errors apart without parsing their text.
+package fault
+
+// Error is a domain error with a stable code. Errors are compared by
+// identity, so they are declared once as package level variables.
+type Error struct {
+	code string
+	text string
+}
+
+func New(code, text string) *Error {
+	return &Error{
+		code: code,
+		text: text,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.text
+}
+
+func (e *Error) Code() string {
+	return e.code
+}
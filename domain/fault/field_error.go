@@ -0,0 +1,49 @@
+package fault
+
+const (
+	CodeRequired       = "REQUIRED"
+	CodeNotAllowed     = "NOT_ALLOWED"
+	CodeInvalid        = "INVALID"
+	CodeNotOneOf       = "NOT_ONE_OF"
+	CodeTooShort       = "TOO_SHORT"
+	CodeTooLong        = "TOO_LONG"
+	CodeWhitespace     = "SURROUNDING_WHITESPACE"
+	CodeInPast         = "IN_PAST"
+	CodeTooFarInFuture = "TOO_FAR_IN_FUTURE"
+	CodeBeforeSendAt   = "BEFORE_SEND_AT"
+	CodeNotUnique      = "NOT_UNIQUE"
+)
+
+// FieldError is a validation error of a single field of a request. Its text
+// is kept for logs and humans, clients rely on the field, code and params.
+type FieldError struct {
+	field  string
+	code   string
+	params map[string]any
+	text   string
+}
+
+func NewFieldError(field, code, text string, params map[string]any) *FieldError {
+	return &FieldError{
+		field:  field,
+		code:   code,
+		params: params,
+		text:   text,
+	}
+}
+
+func (e *FieldError) Error() string {
+	return e.text
+}
+
+func (e *FieldError) Field() string {
+	return e.field
+}
+
+func (e *FieldError) Code() string {
+	return e.code
+}
+
+func (e *FieldError) Params() map[string]any {
+	return e.params
+}
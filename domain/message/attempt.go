@@ -0,0 +1,30 @@
+package message
+
+import "time"
+
+type AttemptStatus string
+
+type ErrorClass string
+
+const (
+	AttemptStatusSucceeded AttemptStatus = "SUCCEEDED"
+	AttemptStatusFailed    AttemptStatus = "FAILED"
+
+	ErrorClassTemporary ErrorClass = "TEMPORARY"
+	ErrorClassPermanent ErrorClass = "PERMANENT"
+)
+
+// Attempt is a request made to a provider to deliver a message. The response
+// status is the status code the provider replied with, 0 when it did not
+// reply. Failed attempts are classified as temporary when they are retried.
+type Attempt struct {
+	ID                string
+	MessageID         string
+	CreatedAt         time.Time
+	Provider          string
+	ProviderMessageID string
+	Status            AttemptStatus
+	ResponseStatus    int
+	Latency           time.Duration
+	ErrorClass        ErrorClass
+}
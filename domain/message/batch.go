@@ -0,0 +1,35 @@
+package message
+
+import (
+	"fmt"
+
+	"messager/domain/fault"
+)
+
+var ErrMessageDoesNotValidForCreateBatch = fault.New("MESSAGE_BATCH_INVALID_FOR_CREATE", "message does not valid for create batch")
+
+// CreateResult is the outcome of creating one message of a batch. Either
+// Message or Err is set.
+type CreateResult struct {
+	Message *Message
+	Err     error
+}
+
+func ValidateForCreateBatch(messages []Message, maxBatchSize int) error {
+	return ValidateBatchSize(len(messages), maxBatchSize)
+}
+
+// ValidateBatchSize validates the number of items of a batch, so that a batch
+// can be refused before its items are parsed.
+func ValidateBatchSize(size, maxBatchSize int) error {
+	if size == 0 {
+		return fault.NewFieldError("items", fault.CodeRequired, "message batch must not be empty", nil)
+	}
+
+	if size > maxBatchSize {
+		return fault.NewFieldError("items", fault.CodeTooLong, fmt.Sprintf("message batch must not exceed %d messages", maxBatchSize),
+			map[string]any{"max": maxBatchSize})
+	}
+
+	return nil
+}
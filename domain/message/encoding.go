@@ -0,0 +1,96 @@
+package message
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	EncodingGSM7 Encoding = "GSM-7"
+	EncodingUCS2 Encoding = "UCS-2"
+
+	gsm7SingleSegmentSeptets = 160
+	gsm7MultiSegmentSeptets  = 153
+	ucs2SingleSegmentUnits   = 70
+	ucs2MultiSegmentUnits    = 67
+)
+
+const (
+	// gsm7BasicCharacters is the GSM 03.38 basic character set, each encoded
+	// in a single septet. The escape character is left out on purpose.
+	gsm7BasicCharacters = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+		"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+	// gsm7ExtensionCharacters is the GSM 03.38 extension table, each encoded
+	// as an escape septet followed by the character septet.
+	gsm7ExtensionCharacters = "\f^{}\\[~]|€"
+)
+
+type Encoding string
+
+// CountSegments detects the encoding the content is sent with and the number
+// of segments it is split into. Concatenated segments reserve room for the
+// user data header, and a character is never split across two segments.
+func CountSegments(content string) (Encoding, int) {
+	if content == "" {
+		return EncodingGSM7, 0
+	}
+
+	units, ok := gsm7Units(content)
+	if ok {
+		return EncodingGSM7, countSegments(units, gsm7SingleSegmentSeptets, gsm7MultiSegmentSeptets)
+	}
+
+	return EncodingUCS2, countSegments(ucs2Units(content), ucs2SingleSegmentUnits, ucs2MultiSegmentUnits)
+}
+
+func gsm7Units(content string) ([]int, bool) {
+	units := make([]int, 0, len(content))
+
+	for _, character := range content {
+		switch {
+		case strings.ContainsRune(gsm7BasicCharacters, character):
+			units = append(units, 1)
+		case strings.ContainsRune(gsm7ExtensionCharacters, character):
+			units = append(units, 2)
+		default:
+			return nil, false
+		}
+	}
+
+	return units, true
+}
+
+func ucs2Units(content string) []int {
+	units := make([]int, 0, len(content))
+
+	for _, character := range content {
+		units = append(units, len(utf16.Encode([]rune{character})))
+	}
+
+	return units
+}
+
+func countSegments(units []int, singleSegmentLength, multiSegmentLength int) int {
+	total := 0
+	for _, unit := range units {
+		total += unit
+	}
+
+	if total <= singleSegmentLength {
+		return 1
+	}
+
+	segments, length := 1, 0
+
+	for _, unit := range units {
+		if length+unit > multiSegmentLength {
+			segments++
+			length = 0
+		}
+
+		length += unit
+	}
+
+	return segments
+}
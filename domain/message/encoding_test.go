@@ -0,0 +1,116 @@
+package message
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountSegments(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		wantEncoding Encoding
+		wantSegments int
+	}{
+		{
+			name:         "empty content",
+			content:      "",
+			wantEncoding: EncodingGSM7,
+			wantSegments: 0,
+		},
+		{
+			name:         "short gsm-7 content",
+			content:      "Hello, world!",
+			wantEncoding: EncodingGSM7,
+			wantSegments: 1,
+		},
+		{
+			name:         "gsm-7 content at single segment limit",
+			content:      strings.Repeat("a", 160),
+			wantEncoding: EncodingGSM7,
+			wantSegments: 1,
+		},
+		{
+			name:         "gsm-7 content over single segment limit",
+			content:      strings.Repeat("a", 161),
+			wantEncoding: EncodingGSM7,
+			wantSegments: 2,
+		},
+		{
+			name:         "gsm-7 content at two segments limit",
+			content:      strings.Repeat("a", 306),
+			wantEncoding: EncodingGSM7,
+			wantSegments: 2,
+		},
+		{
+			name:         "gsm-7 content over two segments limit",
+			content:      strings.Repeat("a", 307),
+			wantEncoding: EncodingGSM7,
+			wantSegments: 3,
+		},
+		{
+			name:         "gsm-7 extension characters count twice",
+			content:      strings.Repeat("€", 80),
+			wantEncoding: EncodingGSM7,
+			wantSegments: 1,
+		},
+		{
+			name:         "gsm-7 extension characters over single segment limit",
+			content:      strings.Repeat("€", 81),
+			wantEncoding: EncodingGSM7,
+			wantSegments: 2,
+		},
+		{
+			name:         "gsm-7 extension character is not split across segments",
+			content:      strings.Repeat("a", 152) + "€" + strings.Repeat("a", 152),
+			wantEncoding: EncodingGSM7,
+			wantSegments: 3,
+		},
+		{
+			name:         "turkish characters switch to ucs-2",
+			content:      "Merhaba, nasılsın? Şu an görüşebilir miyiz?",
+			wantEncoding: EncodingUCS2,
+			wantSegments: 1,
+		},
+		{
+			name:         "ucs-2 content at single segment limit",
+			content:      strings.Repeat("ş", 70),
+			wantEncoding: EncodingUCS2,
+			wantSegments: 1,
+		},
+		{
+			name:         "ucs-2 content over single segment limit",
+			content:      strings.Repeat("ğ", 71),
+			wantEncoding: EncodingUCS2,
+			wantSegments: 2,
+		},
+		{
+			name:         "ucs-2 content over two segments limit",
+			content:      strings.Repeat("ğ", 135),
+			wantEncoding: EncodingUCS2,
+			wantSegments: 3,
+		},
+		{
+			name:         "surrogate pairs count twice",
+			content:      strings.Repeat("😀", 35),
+			wantEncoding: EncodingUCS2,
+			wantSegments: 1,
+		},
+		{
+			name:         "surrogate pairs are not split across segments",
+			content:      strings.Repeat("😀", 67),
+			wantEncoding: EncodingUCS2,
+			wantSegments: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoding, segments := CountSegments(tt.content)
+			assert.Equal(t, tt.wantEncoding, encoding)
+			assert.Equal(t, tt.wantSegments, segments)
+		})
+	}
+}
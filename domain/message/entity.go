@@ -1,120 +1,684 @@
 package message
 
 import (
-	"errors"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/mail"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/nyaruka/phonenumbers"
+	"golang.org/x/text/language"
+
+	"messager/domain/fault"
 )
 
 const (
-	StatusPending Status = "PENDING"
-	StatusSent    Status = "SENT"
+	StatusPending   Status = "PENDING"
+	StatusQueued    Status = "QUEUED"
+	StatusSending   Status = "SENDING"
+	StatusSent      Status = "SENT"
+	StatusDelivered Status = "DELIVERED"
+	StatusFailed    Status = "FAILED"
+	StatusCancelled Status = "CANCELLED"
+	StatusExpired   Status = "EXPIRED"
+
+	PriorityTransactional Priority = "TRANSACTIONAL"
+	PriorityNormal        Priority = "NORMAL"
+	PriorityBulk          Priority = "BULK"
+
+	CategoryTransactional Category = "TRANSACTIONAL"
+	CategoryMarketing     Category = "MARKETING"
+
+	ChannelSMS   Channel = "SMS"
+	ChannelEmail Channel = "EMAIL"
 
-	minContentLength   = 10
-	maxContentLength   = 255
-	defaultPhoneRegion = "TR"
+	TimeZoneRecipient = "recipient"
+
+	minContentLength        = 10
+	maxEmailContentLength   = 100000
+	maxSubjectLength        = 255
+	maxIdempotencyKeyLength = 255
+	sendAtTolerance         = time.Minute
+	maxSendAtHorizon        = 90 * 24 * time.Hour
 )
 
 var (
-	ErrMessageDoesNotValidForCreate        = errors.New("message does not valid for create")
-	ErrMessageDoesNotValidForListByStatus  = errors.New("message does not valid for list by status")
-	ErrMessageDoesNotValidForSent          = errors.New("message does not valid for sent")
-	ErrMessageNotFound                     = errors.New("message not found")
-	ErrMessageStatusDoesNotEligibleForSent = errors.New("message status does not eligible for sent")
+	ErrMessageChannelNotSupported          = fault.New("MESSAGE_CHANNEL_NOT_SUPPORTED", "message channel not supported")
+	ErrMessageDoesNotValidForCreate        = fault.New("MESSAGE_INVALID_FOR_CREATE", "message does not valid for create")
+	ErrMessageDoesNotValidForGet           = fault.New("MESSAGE_INVALID_FOR_GET", "message does not valid for get")
+	ErrMessageDoesNotValidForListByStatus  = fault.New("MESSAGE_INVALID_FOR_LIST_BY_STATUS", "message does not valid for list by status")
+	ErrMessageDoesNotValidForListEvents    = fault.New("MESSAGE_INVALID_FOR_LIST_EVENTS", "message does not valid for list events")
+	ErrMessageDoesNotValidForSent          = fault.New("MESSAGE_INVALID_FOR_SENT", "message does not valid for sent")
+	ErrMessageExpired                      = fault.New("MESSAGE_EXPIRED", "message expired")
+	ErrMessageIdempotencyKeyInProgress     = fault.New("MESSAGE_IDEMPOTENCY_KEY_IN_PROGRESS", "message idempotency key in progress")
+	ErrMessageIdempotencyKeyReused         = fault.New("MESSAGE_IDEMPOTENCY_KEY_REUSED", "message idempotency key reused with a different request")
+	ErrMessageNotFound                     = fault.New("MESSAGE_NOT_FOUND", "message not found")
+	ErrMessageRecipientOptedOut            = fault.New("MESSAGE_RECIPIENT_OPTED_OUT", "message recipient opted out")
+	ErrMessageStatusDoesNotEligibleForSent = fault.New("MESSAGE_STATUS_NOT_ELIGIBLE_FOR_SENT", "message status does not eligible for sent")
+	ErrMessageStatusTransitionNotAllowed   = fault.New("MESSAGE_STATUS_TRANSITION_NOT_ALLOWED", "message status transition not allowed")
 )
 
+// Priorities lists the priorities in the order they are dispatched.
+var Priorities = []Priority{PriorityTransactional, PriorityNormal, PriorityBulk}
+
+var transitions = map[Status][]Status{
+	StatusPending: {StatusQueued, StatusSending, StatusCancelled, StatusExpired},
+	StatusQueued:  {StatusPending, StatusSending, StatusCancelled, StatusExpired},
+	StatusSending: {StatusPending, StatusQueued, StatusSent, StatusFailed, StatusCancelled, StatusExpired},
+	StatusSent:    {StatusDelivered, StatusFailed},
+}
+
 type Message struct {
-	ID        string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Content   string
-	Phone     string
-	Status    Status
+	ID             string
+	TenantID       string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Channel        Channel
+	Content        string
+	HTMLContent    string
+	Subject        string
+	Phone          string
+	Email          string
+	Sender         string
+	Status         Status
+	SendAt         time.Time
+	TimeZone       string
+	ValidUntil     time.Time
+	Priority       Priority
+	Category       Category
+	Encoding       Encoding
+	Segments       int
+	CountryCode    int
+	Region         string
+	TemplateID     string
+	Locale         string
+	Variables      map[string]string
+	CampaignID     string
+	Metadata       map[string]string
+	Tags           []string
+	IdempotencyKey string
+	// Attempts are the delivery attempts of the message, loaded by Get only.
+	Attempts []Attempt
+}
+
+type CreateOptions struct {
+	MaxSegments   int
+	DefaultRegion string
 }
 
 type Status string
 
+type Priority string
+
+type Category string
+
+type Channel string
+
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+func (m *Message) NewErrMessageChannelNotSupported() error {
+	return ErrMessageChannelNotSupported
+}
+
 func (m *Message) NewErrMessageDoesNotValidForCreate() error {
 	return ErrMessageDoesNotValidForCreate
 }
 
+func (m *Message) NewErrMessageDoesNotValidForGet() error {
+	return ErrMessageDoesNotValidForGet
+}
+
 func (m *Message) NewErrMessageDoesNotValidForListByStatus() error {
 	return ErrMessageDoesNotValidForListByStatus
 }
 
+func (m *Message) NewErrMessageDoesNotValidForListEvents() error {
+	return ErrMessageDoesNotValidForListEvents
+}
+
 func (m *Message) NewErrMessageDoesNotValidForSent() error {
 	return ErrMessageDoesNotValidForSent
 }
 
+func (m *Message) NewErrMessageExpired() error {
+	return ErrMessageExpired
+}
+
+func (m *Message) NewErrMessageIdempotencyKeyInProgress() error {
+	return ErrMessageIdempotencyKeyInProgress
+}
+
+func (m *Message) NewErrMessageIdempotencyKeyReused() error {
+	return ErrMessageIdempotencyKeyReused
+}
+
 func (m *Message) NewErrMessageNotFound() error {
 	return ErrMessageNotFound
 }
 
+func (m *Message) NewErrMessageRecipientOptedOut() error {
+	return ErrMessageRecipientOptedOut
+}
+
 func (m *Message) NewErrMessageStatusDoesNotEligibleForSent() error {
 	return ErrMessageStatusDoesNotEligibleForSent
 }
 
-func (m *Message) ValidateForCreate() error {
+func (m *Message) NewErrMessageStatusTransitionNotAllowed(to Status) error {
+	return &TransitionError{
+		From: m.Status,
+		To:   to,
+	}
+}
+
+func (m *Message) CanTransitionTo(status Status) bool {
+	for _, allowed := range transitions[m.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Message) TransitionTo(status Status) error {
+	if !m.CanTransitionTo(status) {
+		return m.NewErrMessageStatusTransitionNotAllowed(status)
+	}
+
+	m.Status = status
+
+	return nil
+}
+
+func (m *Message) ValidateForIdempotency() error {
+	if len(m.IdempotencyKey) > maxIdempotencyKeyLength {
+		return fault.NewFieldError("idempotencyKey", fault.CodeTooLong,
+			fmt.Sprintf("message idempotency key must not exceed %d characters", maxIdempotencyKeyLength),
+			map[string]any{"max": maxIdempotencyKeyLength})
+	}
+
+	for _, r := range m.IdempotencyKey {
+		if r < '!' || r > '~' {
+			return fault.NewFieldError("idempotencyKey", fault.CodeInvalid, "message idempotency key must contain only visible ASCII characters", nil)
+		}
+	}
+
+	return nil
+}
+
+// Fingerprint returns a digest of the fields a message is created from, so
+// that requests reusing an idempotency key can be told apart.
+func (m *Message) Fingerprint() string {
+	data, _ := json.Marshal(struct {
+		Channel     Channel
+		Content     string
+		HTMLContent string
+		Subject     string
+		Phone       string
+		Email       string
+		Sender      string
+		SendAt      time.Time
+		TimeZone    string
+		ValidUntil  time.Time
+		Priority    Priority
+		Category    Category
+		TemplateID  string
+		Locale      string
+		Variables   map[string]string
+		Metadata    map[string]string
+		Tags        []string
+	}{
+		m.Channel, m.Content, m.HTMLContent, m.Subject, m.Phone, m.Email, m.Sender,
+		m.SendAt, m.TimeZone, m.ValidUntil, m.Priority, m.Category, m.TemplateID, m.Locale, m.Variables,
+		m.Metadata, m.Tags,
+	})
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateForRender validates a message created from a template, before its
+// content is rendered.
+func (m *Message) ValidateForRender() error {
+	if m.Content != "" {
+		return fault.NewFieldError("content", fault.CodeNotAllowed, "message content must not be provided with template id", nil)
+	}
+
+	if err := uuid.Validate(m.TemplateID); err != nil {
+		return fault.NewFieldError("templateId", fault.CodeInvalid, fmt.Sprintf("message template id must be a valid uuid: %v", err), nil)
+	}
+
+	if m.Locale != "" {
+		if _, err := language.Parse(m.Locale); err != nil {
+			return fault.NewFieldError("locale", fault.CodeInvalid, "message locale must be a valid BCP 47 language tag", nil)
+		}
+	}
+
+	return nil
+}
+
+func (m *Message) ValidateForCreate(options CreateOptions) error {
+	if m.Channel != "" && !m.Channel.IsValid() {
+		return fault.NewFieldError("channel", fault.CodeNotOneOf, "message channel must be one of SMS or EMAIL",
+			map[string]any{"allowed": []Channel{ChannelSMS, ChannelEmail}})
+	}
+
+	if m.TemplateID == "" && len(m.Variables) > 0 {
+		return fault.NewFieldError("variables", fault.CodeNotAllowed, "message variables must not be provided without template id", nil)
+	}
+
+	if m.TemplateID == "" && m.Locale != "" {
+		return fault.NewFieldError("locale", fault.CodeNotAllowed, "message locale must not be provided without template id", nil)
+	}
+
+	var err error
+	if m.Channel == ChannelEmail {
+		err = m.validateEmailForCreate()
+	} else {
+		err = m.validateSMSForCreate(options)
+	}
+	if err != nil {
+		return err
+	}
+
+	if m.Status != StatusPending {
+		return fault.NewFieldError("status", fault.CodeNotOneOf, "message status must be pending",
+			map[string]any{"allowed": []Status{StatusPending}})
+	}
+
+	if m.Priority != "" && !m.Priority.IsValid() {
+		return fault.NewFieldError("priority", fault.CodeNotOneOf, "message priority must be one of TRANSACTIONAL, NORMAL or BULK",
+			map[string]any{"allowed": Priorities})
+	}
+
+	if m.Category != "" && !m.Category.IsValid() {
+		return fault.NewFieldError("category", fault.CodeNotOneOf, "message category must be one of TRANSACTIONAL or MARKETING",
+			map[string]any{"allowed": []Category{CategoryTransactional, CategoryMarketing}})
+	}
+
+	if m.TimeZone != "" && m.SendAt.IsZero() {
+		return fault.NewFieldError("sendAt", fault.CodeRequired, "message send at must be provided when time zone is provided", nil)
+	}
+
+	sendAt, err := m.scheduledAt(options.DefaultRegion)
+	if err != nil {
+		return fault.NewFieldError("timeZone", fault.CodeInvalid, "message time zone must be a valid IANA time zone or recipient", nil)
+	}
+
+	if !sendAt.IsZero() && sendAt.Before(time.Now().Add(-sendAtTolerance)) {
+		return fault.NewFieldError("sendAt", fault.CodeInPast, "message send at must not be in the past", nil)
+	}
+
+	if !sendAt.IsZero() && sendAt.After(time.Now().Add(maxSendAtHorizon)) {
+		return fault.NewFieldError("sendAt", fault.CodeTooFarInFuture,
+			fmt.Sprintf("message send at must not be more than %d days in the future", int(maxSendAtHorizon.Hours()/24)),
+			map[string]any{"maxDays": int(maxSendAtHorizon.Hours() / 24)})
+	}
+
+	if !m.ValidUntil.IsZero() && !m.ValidUntil.After(time.Now()) {
+		return fault.NewFieldError("validUntil", fault.CodeInPast, "message valid until must be in the future", nil)
+	}
+
+	if !m.ValidUntil.IsZero() && !sendAt.IsZero() && !m.ValidUntil.After(sendAt) {
+		return fault.NewFieldError("validUntil", fault.CodeBeforeSendAt, "message valid until must be after send at", nil)
+	}
+
+	if err := m.validateMetadata(); err != nil {
+		return err
+	}
+
+	return m.validateTags()
+}
+
+func (m *Message) validateSMSForCreate(options CreateOptions) error {
 	if m.Content == "" {
-		return errors.New("message content must be provided")
+		return fault.NewFieldError("content", fault.CodeRequired, "message content must be provided", nil)
 	}
 
-	if len(m.Content) < minContentLength {
-		return fmt.Errorf("message content must be at least %d characters long", minContentLength)
+	if utf8.RuneCountInString(m.Content) < minContentLength {
+		return fault.NewFieldError("content", fault.CodeTooShort,
+			fmt.Sprintf("message content must be at least %d characters long", minContentLength),
+			map[string]any{"min": minContentLength})
 	}
 
-	if len(m.Content) > maxContentLength {
-		return fmt.Errorf("message content must not exceed %d characters", maxContentLength)
+	if _, segments := CountSegments(m.Content); segments > options.MaxSegments {
+		return fault.NewFieldError("content", fault.CodeTooLong,
+			fmt.Sprintf("message content must not exceed %d segments", options.MaxSegments),
+			map[string]any{"maxSegments": options.MaxSegments})
 	}
 
 	if strings.TrimSpace(m.Content) != m.Content {
-		return errors.New("message content must not contain leading or trailing whitespace")
+		return fault.NewFieldError("content", fault.CodeWhitespace, "message content must not contain leading or trailing whitespace", nil)
 	}
 
 	if m.Phone == "" {
-		return errors.New("message phone must be provided")
+		return fault.NewFieldError("phone", fault.CodeRequired, "message phone must be provided", nil)
 	}
 
 	if strings.TrimSpace(m.Phone) != m.Phone {
-		return errors.New("message phone must not contain leading or trailing whitespace")
+		return fault.NewFieldError("phone", fault.CodeWhitespace, "message phone must not contain leading or trailing whitespace", nil)
 	}
 
-	if _, err := phonenumbers.Parse(m.Phone, defaultPhoneRegion); err != nil {
-		return errors.New("message phone must be a valid phone number")
+	if number, err := phonenumbers.Parse(m.Phone, options.DefaultRegion); err != nil || !phonenumbers.IsValidNumber(number) {
+		return fault.NewFieldError("phone", fault.CodeInvalid, "message phone must be a valid phone number", nil)
+	}
+	if m.Email != "" {
+		return fault.NewFieldError("email", fault.CodeNotAllowed, "message email must not be provided for sms channel", nil)
 	}
 
-	if m.Status != StatusPending {
-		return errors.New("message status must be pending")
+	if m.Subject != "" {
+		return fault.NewFieldError("subject", fault.CodeNotAllowed, "message subject must not be provided for sms channel", nil)
+	}
+
+	if m.HTMLContent != "" {
+		return fault.NewFieldError("html", fault.CodeNotAllowed, "message html must not be provided for sms channel", nil)
 	}
 
 	return nil
 }
 
+func (m *Message) validateEmailForCreate() error {
+	if m.Subject == "" {
+		return fault.NewFieldError("subject", fault.CodeRequired, "message subject must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(m.Subject) > maxSubjectLength {
+		return fault.NewFieldError("subject", fault.CodeTooLong,
+			fmt.Sprintf("message subject must not exceed %d characters", maxSubjectLength),
+			map[string]any{"max": maxSubjectLength})
+	}
+
+	if strings.ContainsAny(m.Subject, "\r\n") {
+		return fault.NewFieldError("subject", fault.CodeInvalid, "message subject must not contain line breaks", nil)
+	}
+
+	if strings.TrimSpace(m.Subject) != m.Subject {
+		return fault.NewFieldError("subject", fault.CodeWhitespace, "message subject must not contain leading or trailing whitespace", nil)
+	}
+
+	if m.Content == "" {
+		return fault.NewFieldError("content", fault.CodeRequired, "message content must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(m.Content) > maxEmailContentLength {
+		return fault.NewFieldError("content", fault.CodeTooLong,
+			fmt.Sprintf("message content must not exceed %d characters", maxEmailContentLength),
+			map[string]any{"max": maxEmailContentLength})
+	}
+
+	if utf8.RuneCountInString(m.HTMLContent) > maxEmailContentLength {
+		return fault.NewFieldError("html", fault.CodeTooLong,
+			fmt.Sprintf("message html must not exceed %d characters", maxEmailContentLength),
+			map[string]any{"max": maxEmailContentLength})
+	}
+
+	if m.Email == "" {
+		return fault.NewFieldError("email", fault.CodeRequired, "message email must be provided", nil)
+	}
+
+	if strings.TrimSpace(m.Email) != m.Email {
+		return fault.NewFieldError("email", fault.CodeWhitespace, "message email must not contain leading or trailing whitespace", nil)
+	}
+
+	if _, err := mail.ParseAddress(m.Email); err != nil {
+		return fault.NewFieldError("email", fault.CodeInvalid, "message email must be a valid RFC 5322 address", nil)
+	}
+
+	if m.Phone != "" {
+		return fault.NewFieldError("phone", fault.CodeNotAllowed, "message phone must not be provided for email channel", nil)
+	}
+
+	if m.Sender != "" {
+		return fault.NewFieldError("from", fault.CodeNotAllowed, "message from must not be provided for email channel", nil)
+	}
+
+	return nil
+}
+
+// NormalizeForCreate resolves the fields of a message validated by
+// ValidateForCreate into the form it is persisted with.
+func (m *Message) NormalizeForCreate(options CreateOptions) error {
+	if m.Channel == "" {
+		m.Channel = ChannelSMS
+	}
+
+	sendAt, err := m.scheduledAt(options.DefaultRegion)
+	if err != nil {
+		return err
+	}
+
+	if m.Channel == ChannelEmail {
+		address, err := mail.ParseAddress(m.Email)
+		if err != nil {
+			return fmt.Errorf("mail.ParseAddress(): %w", err)
+		}
+
+		m.Email = address.Address
+	} else {
+		number, err := phonenumbers.Parse(m.Phone, options.DefaultRegion)
+		if err != nil {
+			return fmt.Errorf("phonenumbers.Parse(): %w", err)
+		}
+
+		m.Phone = phonenumbers.Format(number, phonenumbers.E164)
+		m.CountryCode = int(number.GetCountryCode())
+		m.Region = phonenumbers.GetRegionCodeForNumber(number)
+		m.Encoding, m.Segments = CountSegments(m.Content)
+	}
+
+	if sendAt.IsZero() {
+		sendAt = time.Now()
+	}
+
+	m.SendAt = sendAt.UTC()
+	m.TimeZone = ""
+
+	if m.Priority == "" {
+		m.Priority = PriorityNormal
+	}
+
+	if m.Category == "" {
+		m.Category = CategoryMarketing
+	}
+
+	if !m.ValidUntil.IsZero() {
+		m.ValidUntil = m.ValidUntil.UTC()
+	}
+
+	m.normalizeTags()
+
+	return nil
+}
+
+func (m *Message) IsExpired(now time.Time) bool {
+	return !m.ValidUntil.IsZero() && !m.ValidUntil.After(now)
+}
+
+// PhoneRegion returns the region the message phone belongs to, or an empty
+// string when the phone cannot be parsed.
+func (m *Message) PhoneRegion(defaultRegion string) string {
+	number, err := phonenumbers.Parse(m.Phone, defaultRegion)
+	if err != nil {
+		return ""
+	}
+
+	return phonenumbers.GetRegionCodeForNumber(number)
+}
+
+// RecipientLocation returns the time zone of the region the message phone
+// belongs to.
+func (m *Message) RecipientLocation() (*time.Location, error) {
+	return m.recipientLocation(m.Region)
+}
+
+func (m *Message) recipientLocation(defaultRegion string) (*time.Location, error) {
+	number, err := phonenumbers.Parse(m.Phone, defaultRegion)
+	if err != nil {
+		return nil, fmt.Errorf("phonenumbers.Parse(): %w", err)
+	}
+
+	timeZones, err := phonenumbers.GetTimezonesForNumber(number)
+	if err != nil {
+		return nil, fmt.Errorf("phonenumbers.GetTimezonesForNumber(): %w", err)
+	}
+
+	if len(timeZones) == 0 || timeZones[0] == phonenumbers.UNKNOWN_TIMEZONE {
+		return nil, fmt.Errorf("phone %s does not belong to a known time zone", m.Phone)
+	}
+
+	location, err := time.LoadLocation(timeZones[0])
+	if err != nil {
+		return nil, fmt.Errorf("time.LoadLocation(): %w", err)
+	}
+
+	return location, nil
+}
+
+// scheduledAt returns the send at of the message as an absolute time. When a
+// time zone is provided, send at is treated as a wall clock time in it.
+func (m *Message) scheduledAt(defaultRegion string) (time.Time, error) {
+	if m.SendAt.IsZero() || m.TimeZone == "" {
+		return m.SendAt, nil
+	}
+
+	var (
+		location *time.Location
+		err      error
+	)
+
+	if m.TimeZone == TimeZoneRecipient {
+		location, err = m.recipientLocation(defaultRegion)
+	} else {
+		location, err = time.LoadLocation(m.TimeZone)
+	}
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(
+		m.SendAt.Year(), m.SendAt.Month(), m.SendAt.Day(),
+		m.SendAt.Hour(), m.SendAt.Minute(), m.SendAt.Second(), m.SendAt.Nanosecond(),
+		location,
+	), nil
+}
+
 func (m *Message) ValidateForListByStatus() error {
 	if m.Status == "" {
-		return errors.New("message status must be provided")
+		return fault.NewFieldError("status", fault.CodeRequired, "message status must be provided", nil)
 	}
 
-	switch m.Status {
-	case StatusPending, StatusSent:
-		return nil
-	default:
-		return errors.New("message status must be one of PENDING or SENT")
+	if !m.Status.IsValid() {
+		return fault.NewFieldError("status", fault.CodeNotOneOf,
+			"message status must be one of PENDING, QUEUED, SENDING, SENT, DELIVERED, FAILED, CANCELLED or EXPIRED",
+			map[string]any{"allowed": []Status{
+				StatusPending, StatusQueued, StatusSending, StatusSent, StatusDelivered, StatusFailed, StatusCancelled, StatusExpired,
+			}})
+	}
+
+	return nil
+}
+
+func (m *Message) ValidateForGet() error {
+	if m.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "message id must be provided", nil)
+	}
+
+	if err := uuid.Validate(m.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("message id must be a valid uuid: %v", err), nil)
 	}
+
+	return nil
+}
+
+func (m *Message) ValidateForListEvents() error {
+	if m.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "message id must be provided", nil)
+	}
+
+	if err := uuid.Validate(m.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("message id must be a valid uuid: %v", err), nil)
+	}
+
+	return nil
 }
 
 func (m *Message) ValidateForSent() error {
 	if m.ID == "" {
-		return errors.New("message id must be provided")
+		return fault.NewFieldError("id", fault.CodeRequired, "message id must be provided", nil)
 	}
 
 	if err := uuid.Validate(m.ID); err != nil {
-		return fmt.Errorf("message id must be a valid uuid: %w", err)
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("message id must be a valid uuid: %v", err), nil)
 	}
 
 	return nil
 }
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPending, StatusQueued, StatusSending, StatusSent, StatusDelivered, StatusFailed, StatusCancelled, StatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s Status) IsFinal() bool {
+	return len(transitions[s]) == 0
+}
+
+func (p Priority) IsValid() bool {
+	switch p {
+	case PriorityTransactional, PriorityNormal, PriorityBulk:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c Category) IsValid() bool {
+	switch c {
+	case CategoryTransactional, CategoryMarketing:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelSMS, ChannelEmail:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiresConsent reports whether messages of the category are refused to
+// recipients who opted out of it. Transactional messages bypass consent.
+func (c Category) RequiresConsent() bool {
+	return c == CategoryMarketing
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("message status cannot transition from %s to %s", e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrMessageStatusTransitionNotAllowed
+}
+
+func (e *TransitionError) Code() string {
+	return ErrMessageStatusTransitionNotAllowed.Code()
+}
@@ -1,12 +1,21 @@
 package message
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
 )
 
+var testCreateOptions = CreateOptions{
+	MaxSegments:   4,
+	DefaultRegion: "TR",
+}
+
 func TestMessage_ValidateForCreate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -46,12 +55,31 @@ func TestMessage_ValidateForCreate(t *testing.T) {
 		{
 			name: "content too long",
 			message: Message{
-				Content: string(make([]byte, maxContentLength+1)),
+				Content: strings.Repeat("a", 4*153+1),
 				Phone:   "+905551234567",
 				Status:  StatusPending,
 			},
 			wantErr: true,
-			errMsg:  "message content must not exceed 255 characters",
+			errMsg:  "message content must not exceed 4 segments",
+		},
+		{
+			name: "ucs-2 content too long",
+			message: Message{
+				Content: strings.Repeat("ş", 4*67+1),
+				Phone:   "+905551234567",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message content must not exceed 4 segments",
+		},
+		{
+			name: "content length counts characters not bytes",
+			message: Message{
+				Content: "şğüöçıŞĞÜÖ",
+				Phone:   "+905551234567",
+				Status:  StatusPending,
+			},
+			wantErr: false,
 		},
 		{
 			name: "content with leading whitespace",
@@ -133,6 +161,25 @@ func TestMessage_ValidateForCreate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "message phone must be a valid phone number",
 		},
+		{
+			name: "parseable but invalid phone number",
+			message: Message{
+				Content: "This is a valid message content",
+				Phone:   "+90 123",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message phone must be a valid phone number",
+		},
+		{
+			name: "national phone number in default region",
+			message: Message{
+				Content: "This is a valid message content",
+				Phone:   "05551234567",
+				Status:  StatusPending,
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid status",
 			message: Message{
@@ -153,11 +200,259 @@ func TestMessage_ValidateForCreate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "message status must be pending",
 		},
+		{
+			name: "valid send at",
+			message: Message{
+				Content: "This is a valid message content",
+				Phone:   "+905551234567",
+				Status:  StatusPending,
+				SendAt:  time.Now().Add(time.Hour),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid send at with time zone",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				SendAt:   time.Now().Add(24 * time.Hour),
+				TimeZone: "Europe/Istanbul",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid send at with recipient time zone",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				SendAt:   time.Now().Add(24 * time.Hour),
+				TimeZone: TimeZoneRecipient,
+			},
+			wantErr: false,
+		},
+		{
+			name: "send at in the past",
+			message: Message{
+				Content: "This is a valid message content",
+				Phone:   "+905551234567",
+				Status:  StatusPending,
+				SendAt:  time.Now().Add(-time.Hour),
+			},
+			wantErr: true,
+			errMsg:  "message send at must not be in the past",
+		},
+		{
+			name: "send at too far in the future",
+			message: Message{
+				Content: "This is a valid message content",
+				Phone:   "+905551234567",
+				Status:  StatusPending,
+				SendAt:  time.Now().Add(maxSendAtHorizon + time.Hour),
+			},
+			wantErr: true,
+			errMsg:  "message send at must not be more than 90 days in the future",
+		},
+		{
+			name: "time zone without send at",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				TimeZone: "Europe/Istanbul",
+			},
+			wantErr: true,
+			errMsg:  "message send at must be provided when time zone is provided",
+		},
+		{
+			name: "valid priority",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				Priority: PriorityTransactional,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid priority",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				Priority: "URGENT",
+			},
+			wantErr: true,
+			errMsg:  "message priority must be one of TRANSACTIONAL, NORMAL or BULK",
+		},
+		{
+			name: "invalid category",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				Category: "PROMO",
+			},
+			wantErr: true,
+			errMsg:  "message category must be one of TRANSACTIONAL or MARKETING",
+		},
+		{
+			name: "valid until in the future",
+			message: Message{
+				Content:    "This is a valid message content",
+				Phone:      "+905551234567",
+				Status:     StatusPending,
+				ValidUntil: time.Now().Add(time.Hour),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid until in the past",
+			message: Message{
+				Content:    "This is a valid message content",
+				Phone:      "+905551234567",
+				Status:     StatusPending,
+				ValidUntil: time.Now().Add(-time.Hour),
+			},
+			wantErr: true,
+			errMsg:  "message valid until must be in the future",
+		},
+		{
+			name: "valid until before send at",
+			message: Message{
+				Content:    "This is a valid message content",
+				Phone:      "+905551234567",
+				Status:     StatusPending,
+				SendAt:     time.Now().Add(2 * time.Hour),
+				ValidUntil: time.Now().Add(time.Hour),
+			},
+			wantErr: true,
+			errMsg:  "message valid until must be after send at",
+		},
+		{
+			name: "invalid time zone",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				SendAt:   time.Now().Add(time.Hour),
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			wantErr: true,
+			errMsg:  "message time zone must be a valid IANA time zone or recipient",
+		},
+		{
+			name: "invalid channel",
+			message: Message{
+				Channel: "FAX",
+				Content: "This is a valid message content",
+				Phone:   "+905551234567",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message channel must be one of SMS or EMAIL",
+		},
+		{
+			name: "sms with email",
+			message: Message{
+				Channel: ChannelSMS,
+				Content: "This is a valid message content",
+				Phone:   "+905551234567",
+				Email:   "jane@example.com",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message email must not be provided for sms channel",
+		},
+		{
+			name: "valid email",
+			message: Message{
+				Channel:     ChannelEmail,
+				Content:     strings.Repeat("a", 4*153+1),
+				HTMLContent: "<p>Hello</p>",
+				Subject:     "Your order has shipped",
+				Email:       "Jane Doe <jane@example.com>",
+				Status:      StatusPending,
+			},
+			wantErr: false,
+		},
+		{
+			name: "email without subject",
+			message: Message{
+				Channel: ChannelEmail,
+				Content: "This is a valid message content",
+				Email:   "jane@example.com",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message subject must be provided",
+		},
+		{
+			name: "email subject too long",
+			message: Message{
+				Channel: ChannelEmail,
+				Content: "This is a valid message content",
+				Subject: strings.Repeat("s", 256),
+				Email:   "jane@example.com",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message subject must not exceed 255 characters",
+		},
+		{
+			name: "email subject with line break",
+			message: Message{
+				Channel: ChannelEmail,
+				Content: "This is a valid message content",
+				Subject: "Hello\r\nBcc: someone@example.com",
+				Email:   "jane@example.com",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message subject must not contain line breaks",
+		},
+		{
+			name: "email without address",
+			message: Message{
+				Channel: ChannelEmail,
+				Content: "This is a valid message content",
+				Subject: "Your order has shipped",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message email must be provided",
+		},
+		{
+			name: "invalid email address",
+			message: Message{
+				Channel: ChannelEmail,
+				Content: "This is a valid message content",
+				Subject: "Your order has shipped",
+				Email:   "jane.example.com",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message email must be a valid RFC 5322 address",
+		},
+		{
+			name: "email with phone",
+			message: Message{
+				Channel: ChannelEmail,
+				Content: "This is a valid message content",
+				Subject: "Your order has shipped",
+				Email:   "jane@example.com",
+				Phone:   "+905551234567",
+				Status:  StatusPending,
+			},
+			wantErr: true,
+			errMsg:  "message phone must not be provided for email channel",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.message.ValidateForCreate()
+			err := tt.message.ValidateForCreate(testCreateOptions)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Equal(t, tt.errMsg, err.Error())
@@ -168,6 +463,202 @@ func TestMessage_ValidateForCreate(t *testing.T) {
 	}
 }
 
+func TestMessage_ValidateForCreate_FieldError(t *testing.T) {
+	tests := []struct {
+		name       string
+		message    Message
+		wantField  string
+		wantCode   string
+		wantParams map[string]any
+	}{
+		{
+			name:      "empty content",
+			message:   Message{Phone: "+905551234567", Status: StatusPending},
+			wantField: "content",
+			wantCode:  fault.CodeRequired,
+		},
+		{
+			name:       "short content",
+			message:    Message{Content: "short", Phone: "+905551234567", Status: StatusPending},
+			wantField:  "content",
+			wantCode:   fault.CodeTooShort,
+			wantParams: map[string]any{"min": minContentLength},
+		},
+		{
+			name:      "invalid phone",
+			message:   Message{Content: "This is a valid message content", Phone: "12345", Status: StatusPending},
+			wantField: "phone",
+			wantCode:  fault.CodeInvalid,
+		},
+		{
+			name: "invalid priority",
+			message: Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				Priority: "URGENT",
+			},
+			wantField:  "priority",
+			wantCode:   fault.CodeNotOneOf,
+			wantParams: map[string]any{"allowed": Priorities},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fieldErr *fault.FieldError
+
+			err := tt.message.ValidateForCreate(testCreateOptions)
+			assert.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tt.wantField, fieldErr.Field())
+			assert.Equal(t, tt.wantCode, fieldErr.Code())
+			assert.Equal(t, tt.wantParams, fieldErr.Params())
+		})
+	}
+}
+
+func TestMessage_NormalizeForCreate(t *testing.T) {
+	t.Run("defaults send at to now", func(t *testing.T) {
+		message := Message{
+			Phone: "+905551234567",
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.WithinDuration(t, time.Now(), message.SendAt, time.Second)
+		assert.Equal(t, time.UTC, message.SendAt.Location())
+	})
+
+	t.Run("normalizes phone to e.164", func(t *testing.T) {
+		for _, phone := range []string{"05551234567", "+90 555 123 45 67", "+905551234567", "0 (555) 123-45-67"} {
+			message := Message{
+				Phone: phone,
+			}
+
+			assert.NoError(t, message.NormalizeForCreate(testCreateOptions), phone)
+			assert.Equal(t, "+905551234567", message.Phone, phone)
+			assert.Equal(t, 90, message.CountryCode, phone)
+			assert.Equal(t, "TR", message.Region, phone)
+		}
+	})
+
+	t.Run("defaults channel to sms", func(t *testing.T) {
+		message := Message{
+			Phone: "+905551234567",
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, ChannelSMS, message.Channel)
+	})
+
+	t.Run("normalizes email address", func(t *testing.T) {
+		message := Message{
+			Channel: ChannelEmail,
+			Content: "This is a valid message content",
+			Email:   "Jane Doe <jane@example.com>",
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, "jane@example.com", message.Email)
+		assert.Empty(t, message.Phone)
+		assert.Empty(t, message.Encoding)
+		assert.Zero(t, message.Segments)
+	})
+
+	t.Run("keeps country of international phone", func(t *testing.T) {
+		message := Message{
+			Phone: "+44 7400 123456",
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, "+447400123456", message.Phone)
+		assert.Equal(t, 44, message.CountryCode)
+		assert.Equal(t, "GB", message.Region)
+	})
+
+	t.Run("calculates encoding and segments", func(t *testing.T) {
+		message := Message{
+			Content: strings.Repeat("ş", 71),
+			Phone:   "+905551234567",
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, EncodingUCS2, message.Encoding)
+		assert.Equal(t, 2, message.Segments)
+	})
+
+	t.Run("defaults priority to normal", func(t *testing.T) {
+		message := Message{
+			Phone: "+905551234567",
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, PriorityNormal, message.Priority)
+
+		message.Priority = PriorityBulk
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, PriorityBulk, message.Priority)
+	})
+
+	t.Run("keeps absolute send at", func(t *testing.T) {
+		sendAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.FixedZone("", 2*60*60))
+		message := Message{
+			Phone:  "+905551234567",
+			SendAt: sendAt,
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.True(t, sendAt.Equal(message.SendAt))
+	})
+
+	t.Run("resolves wall clock in time zone", func(t *testing.T) {
+		message := Message{
+			Phone:    "+905551234567",
+			SendAt:   time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC),
+			TimeZone: "America/New_York",
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, time.Date(2030, 1, 1, 14, 0, 0, 0, time.UTC), message.SendAt)
+		assert.Empty(t, message.TimeZone)
+	})
+
+	t.Run("resolves wall clock in recipient time zone", func(t *testing.T) {
+		message := Message{
+			Phone:    "+905551234567",
+			SendAt:   time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC),
+			TimeZone: TimeZoneRecipient,
+		}
+
+		assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+		assert.Equal(t, time.Date(2030, 1, 1, 6, 0, 0, 0, time.UTC), message.SendAt)
+	})
+}
+
+func TestMessage_IsExpired(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, (&Message{}).IsExpired(now))
+	assert.False(t, (&Message{ValidUntil: now.Add(time.Minute)}).IsExpired(now))
+	assert.True(t, (&Message{ValidUntil: now}).IsExpired(now))
+	assert.True(t, (&Message{ValidUntil: now.Add(-time.Minute)}).IsExpired(now))
+}
+
+func TestMessage_RecipientLocation(t *testing.T) {
+	message := Message{
+		Phone: "+905551234567",
+	}
+
+	location, err := message.RecipientLocation()
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Istanbul", location.String())
+
+	message.Phone = "invalid-phone"
+
+	_, err = message.RecipientLocation()
+	assert.Error(t, err)
+}
+
 func TestMessage_ValidateForListByStatus(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -182,6 +673,13 @@ func TestMessage_ValidateForListByStatus(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid sending status",
+			message: Message{
+				Status: StatusSending,
+			},
+			wantErr: false,
+		},
 		{
 			name: "valid sent status",
 			message: Message{
@@ -189,6 +687,20 @@ func TestMessage_ValidateForListByStatus(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid failed status",
+			message: Message{
+				Status: StatusFailed,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid expired status",
+			message: Message{
+				Status: StatusExpired,
+			},
+			wantErr: false,
+		},
 		{
 			name: "empty status",
 			message: Message{
@@ -203,7 +715,7 @@ func TestMessage_ValidateForListByStatus(t *testing.T) {
 				Status: "INVALID",
 			},
 			wantErr: true,
-			errMsg:  "message status must be one of PENDING or SENT",
+			errMsg:  "message status must be one of PENDING, QUEUED, SENDING, SENT, DELIVERED, FAILED, CANCELLED or EXPIRED",
 		},
 	}
 
@@ -268,6 +780,153 @@ func TestMessage_ValidateForSent(t *testing.T) {
 	}
 }
 
+func TestMessage_ValidateForListEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid message",
+			message: Message{
+				ID: uuid.New().String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty id",
+			message: Message{
+				ID: "",
+			},
+			wantErr: true,
+			errMsg:  "message id must be provided",
+		},
+		{
+			name: "invalid uuid",
+			message: Message{
+				ID: "invalid-uuid",
+			},
+			wantErr: true,
+			errMsg:  "message id must be a valid uuid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.message.ValidateForListEvents()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMessage_ValidateForGet(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid message",
+			message: Message{
+				ID: uuid.New().String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty id",
+			message: Message{
+				ID: "",
+			},
+			wantErr: true,
+			errMsg:  "message id must be provided",
+		},
+		{
+			name: "invalid uuid",
+			message: Message{
+				ID: "invalid-uuid",
+			},
+			wantErr: true,
+			errMsg:  "message id must be a valid uuid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.message.ValidateForGet()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMessage_TransitionTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    Status
+		to      Status
+		wantErr bool
+	}{
+		{name: "pending to sending", from: StatusPending, to: StatusSending},
+		{name: "pending to queued", from: StatusPending, to: StatusQueued},
+		{name: "pending to cancelled", from: StatusPending, to: StatusCancelled},
+		{name: "pending to expired", from: StatusPending, to: StatusExpired},
+		{name: "queued to sending", from: StatusQueued, to: StatusSending},
+		{name: "sending to sent", from: StatusSending, to: StatusSent},
+		{name: "sending to failed", from: StatusSending, to: StatusFailed},
+		{name: "sending back to pending", from: StatusSending, to: StatusPending},
+		{name: "sending held in queued", from: StatusSending, to: StatusQueued},
+		{name: "sent to delivered", from: StatusSent, to: StatusDelivered},
+		{name: "pending to sent", from: StatusPending, to: StatusSent, wantErr: true},
+		{name: "sent to pending", from: StatusSent, to: StatusPending, wantErr: true},
+		{name: "delivered to failed", from: StatusDelivered, to: StatusFailed, wantErr: true},
+		{name: "cancelled to pending", from: StatusCancelled, to: StatusPending, wantErr: true},
+		{name: "expired to sending", from: StatusExpired, to: StatusSending, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := Message{
+				Status: tt.from,
+			}
+
+			err := message.TransitionTo(tt.to)
+			if tt.wantErr {
+				var transitionErr *TransitionError
+
+				assert.ErrorIs(t, err, ErrMessageStatusTransitionNotAllowed)
+				assert.ErrorAs(t, err, &transitionErr)
+				assert.Equal(t, tt.from, transitionErr.From)
+				assert.Equal(t, tt.to, transitionErr.To)
+				assert.Equal(t, tt.from, message.Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.to, message.Status)
+			}
+		})
+	}
+}
+
+func TestStatus_IsFinal(t *testing.T) {
+	for _, status := range []Status{StatusDelivered, StatusFailed, StatusCancelled, StatusExpired} {
+		assert.True(t, status.IsFinal(), status)
+	}
+
+	for _, status := range []Status{StatusPending, StatusQueued, StatusSending, StatusSent} {
+		assert.False(t, status.IsFinal(), status)
+	}
+}
+
 func TestMessage_ErrorMethods(t *testing.T) {
 	message := &Message{}
 
@@ -281,16 +940,31 @@ func TestMessage_ErrorMethods(t *testing.T) {
 			method:   message.NewErrMessageDoesNotValidForCreate,
 			expected: ErrMessageDoesNotValidForCreate,
 		},
+		{
+			name:     "NewErrMessageDoesNotValidForGet",
+			method:   message.NewErrMessageDoesNotValidForGet,
+			expected: ErrMessageDoesNotValidForGet,
+		},
 		{
 			name:     "NewErrMessageDoesNotValidForListByStatus",
 			method:   message.NewErrMessageDoesNotValidForListByStatus,
 			expected: ErrMessageDoesNotValidForListByStatus,
 		},
+		{
+			name:     "NewErrMessageDoesNotValidForListEvents",
+			method:   message.NewErrMessageDoesNotValidForListEvents,
+			expected: ErrMessageDoesNotValidForListEvents,
+		},
 		{
 			name:     "NewErrMessageDoesNotValidForSent",
 			method:   message.NewErrMessageDoesNotValidForSent,
 			expected: ErrMessageDoesNotValidForSent,
 		},
+		{
+			name:     "NewErrMessageExpired",
+			method:   message.NewErrMessageExpired,
+			expected: ErrMessageExpired,
+		},
 		{
 			name:     "NewErrMessageNotFound",
 			method:   message.NewErrMessageNotFound,
@@ -310,3 +984,58 @@ func TestMessage_ErrorMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestMessage_ErrorCodes(t *testing.T) {
+	assert.Equal(t, "MESSAGE_NOT_FOUND", ErrMessageNotFound.Code())
+	assert.Equal(t, "MESSAGE_INVALID_FOR_CREATE", ErrMessageDoesNotValidForCreate.Code())
+	assert.Equal(t, "MESSAGE_STATUS_TRANSITION_NOT_ALLOWED", (&TransitionError{From: StatusSent, To: StatusPending}).Code())
+}
+
+func TestMessage_ValidateForIdempotency(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid key",
+			message: Message{IdempotencyKey: uuid.New().String()},
+			wantErr: false,
+		},
+		{
+			name:    "key too long",
+			message: Message{IdempotencyKey: strings.Repeat("a", 256)},
+			wantErr: true,
+			errMsg:  "message idempotency key must not exceed 255 characters",
+		},
+		{
+			name:    "key with whitespace",
+			message: Message{IdempotencyKey: "order 42"},
+			wantErr: true,
+			errMsg:  "message idempotency key must contain only visible ASCII characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.message.ValidateForIdempotency()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMessage_Fingerprint(t *testing.T) {
+	message := Message{Content: "This is a valid message content", Phone: "+905551234567"}
+	same := message
+	different := message
+	different.Phone = "+905551234568"
+
+	assert.Equal(t, message.Fingerprint(), same.Fingerprint())
+	assert.NotEqual(t, message.Fingerprint(), different.Fingerprint())
+}
@@ -0,0 +1,11 @@
+package message
+
+import "time"
+
+type Event struct {
+	ID        string
+	MessageID string
+	CreatedAt time.Time
+	From      Status
+	To        Status
+}
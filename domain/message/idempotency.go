@@ -0,0 +1,16 @@
+package message
+
+// IdempotencyRecord ties an idempotency key of a tenant to the fingerprint of
+// the request it was first used with and, once created, to the resulting
+// message.
+type IdempotencyRecord struct {
+	TenantID    string
+	Key         string
+	Fingerprint string
+	MessageID   string
+}
+
+// IsCompleted reports whether the message of the record has been created.
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.MessageID != ""
+}
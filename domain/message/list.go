@@ -0,0 +1,165 @@
+package message
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/nyaruka/phonenumbers"
+
+	"messager/domain/fault"
+)
+
+const (
+	DefaultListLimit   = 50
+	MaxListLimit       = 500
+	maxListPhoneLength = 255
+	maxListQueryLength = 256
+)
+
+// ListFilter narrows the messages of a tenant. Every filter is optional, no
+// status lists the messages in any status. Messages with the metadata key are
+// matched regardless of its value when no metadata value is given. Ranges
+// include their from and exclude their to.
+type ListFilter struct {
+	TenantID      string
+	Statuses      []Status
+	Phone         string
+	CreatedFrom   time.Time
+	CreatedTo     time.Time
+	UpdatedFrom   time.Time
+	UpdatedTo     time.Time
+	Query         string
+	Tag           string
+	MetadataKey   string
+	MetadataValue string
+	Limit         int
+	Cursor        string
+}
+
+// Page is a slice of the messages matching a filter, newest first. NextCursor
+// is empty on the last page.
+type Page struct {
+	Items      []Message
+	NextCursor string
+}
+
+// Cursor is the position of the last message of a page. It is handed to
+// clients as an opaque string, the next page starts right after it.
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func NewCursor(message Message) string {
+	data, _ := json.Marshal(Cursor{CreatedAt: message.CreatedAt, ID: message.ID})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func ParseCursor(value string) (Cursor, error) {
+	var cursor Cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("base64.RawURLEncoding.DecodeString(): %w", err)
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return Cursor{}, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	if cursor.CreatedAt.IsZero() {
+		return Cursor{}, fmt.Errorf("cursor created at must be provided")
+	}
+
+	if err := uuid.Validate(cursor.ID); err != nil {
+		return Cursor{}, fmt.Errorf("uuid.Validate(): %w", err)
+	}
+
+	return cursor, nil
+}
+
+// Normalize trims the filter and formats its phone in E.164 like messages are
+// stored, parsing it in defaultRegion. A phone that cannot be parsed is kept
+// as it is and matches no message.
+func (f *ListFilter) Normalize(defaultRegion string) {
+	f.Phone = strings.TrimSpace(f.Phone)
+	f.Query = strings.TrimSpace(f.Query)
+
+	if f.Phone != "" {
+		if number, err := phonenumbers.Parse(f.Phone, defaultRegion); err == nil {
+			f.Phone = phonenumbers.Format(number, phonenumbers.E164)
+		}
+	}
+
+	if f.Limit == 0 {
+		f.Limit = DefaultListLimit
+	}
+}
+
+func (f *ListFilter) Validate() error {
+	for _, status := range f.Statuses {
+		message := Message{
+			Status: status,
+		}
+
+		if err := message.ValidateForListByStatus(); err != nil {
+			return err
+		}
+	}
+
+	if utf8.RuneCountInString(f.Phone) > maxListPhoneLength {
+		return fault.NewFieldError("phone", fault.CodeTooLong,
+			fmt.Sprintf("message phone must not exceed %d characters", maxListPhoneLength),
+			map[string]any{"max": maxListPhoneLength})
+	}
+
+	if !f.CreatedFrom.IsZero() && !f.CreatedTo.IsZero() && !f.CreatedTo.After(f.CreatedFrom) {
+		return fault.NewFieldError("createdTo", fault.CodeInvalid, "message created to must be after created from", nil)
+	}
+
+	if !f.UpdatedFrom.IsZero() && !f.UpdatedTo.IsZero() && !f.UpdatedTo.After(f.UpdatedFrom) {
+		return fault.NewFieldError("updatedTo", fault.CodeInvalid, "message updated to must be after updated from", nil)
+	}
+
+	if utf8.RuneCountInString(f.Query) > maxListQueryLength {
+		return fault.NewFieldError("q", fault.CodeTooLong,
+			fmt.Sprintf("message search query must not exceed %d characters", maxListQueryLength),
+			map[string]any{"max": maxListQueryLength})
+	}
+
+	if f.Tag != "" {
+		if err := validateTag("tag", f.Tag); err != nil {
+			return err
+		}
+	}
+
+	if f.MetadataValue != "" && f.MetadataKey == "" {
+		return fault.NewFieldError("metadataKey", fault.CodeRequired, "message metadata key must be provided with metadata value", nil)
+	}
+
+	if f.MetadataKey != "" {
+		if err := validateMetadataKey("metadataKey", f.MetadataKey); err != nil {
+			return err
+		}
+	}
+
+	if f.Limit < 1 || f.Limit > MaxListLimit {
+		return fault.NewFieldError("limit", fault.CodeInvalid,
+			fmt.Sprintf("message list limit must be between 1 and %d", MaxListLimit),
+			map[string]any{"min": 1, "max": MaxListLimit})
+	}
+
+	if f.Cursor != "" {
+		if _, err := ParseCursor(f.Cursor); err != nil {
+			return fault.NewFieldError("cursor", fault.CodeInvalid, "message list cursor is invalid", nil)
+		}
+	}
+
+	return nil
+}
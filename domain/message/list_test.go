@@ -0,0 +1,182 @@
+package message
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+)
+
+func TestListFilter_Validate(t *testing.T) {
+	now := time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC)
+	cursor := NewCursor(Message{ID: "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", CreatedAt: now})
+
+	tests := []struct {
+		name      string
+		filter    ListFilter
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name:   "no filters",
+			filter: ListFilter{},
+		},
+		{
+			name:   "status only",
+			filter: ListFilter{Statuses: []Status{StatusSent}},
+		},
+		{
+			name:   "many statuses",
+			filter: ListFilter{Statuses: []Status{StatusSent, StatusDelivered}},
+		},
+		{
+			name:   "tag and metadata",
+			filter: ListFilter{Statuses: []Status{StatusSent}, Tag: "vip", MetadataKey: "orderId", MetadataValue: "42"},
+		},
+		{
+			name:   "metadata key only",
+			filter: ListFilter{Statuses: []Status{StatusSent}, MetadataKey: "orderId"},
+		},
+		{
+			name: "phone, ranges, query and cursor",
+			filter: ListFilter{
+				Phone:       "+905551234567",
+				CreatedFrom: now.Add(-time.Hour),
+				CreatedTo:   now,
+				UpdatedFrom: now.Add(-time.Hour),
+				UpdatedTo:   now,
+				Query:       "order shipped",
+				Limit:       MaxListLimit,
+				Cursor:      cursor,
+			},
+		},
+		{
+			name:    "invalid status",
+			filter:  ListFilter{Statuses: []Status{StatusSent, "INVALID"}, Tag: "vip"},
+			wantErr: true,
+		},
+		{
+			name:      "phone too long",
+			filter:    ListFilter{Phone: strings.Repeat("1", maxListPhoneLength+1)},
+			wantErr:   true,
+			wantField: "phone",
+		},
+		{
+			name:      "created to before created from",
+			filter:    ListFilter{CreatedFrom: now, CreatedTo: now.Add(-time.Hour)},
+			wantErr:   true,
+			wantField: "createdTo",
+		},
+		{
+			name:      "updated to equal to updated from",
+			filter:    ListFilter{UpdatedFrom: now, UpdatedTo: now},
+			wantErr:   true,
+			wantField: "updatedTo",
+		},
+		{
+			name:      "query too long",
+			filter:    ListFilter{Query: strings.Repeat("q", maxListQueryLength+1)},
+			wantErr:   true,
+			wantField: "q",
+		},
+		{
+			name:      "tag with whitespace",
+			filter:    ListFilter{Statuses: []Status{StatusSent}, Tag: " vip"},
+			wantErr:   true,
+			wantField: "tag",
+		},
+		{
+			name:      "metadata value without key",
+			filter:    ListFilter{Statuses: []Status{StatusSent}, MetadataValue: "42"},
+			wantErr:   true,
+			wantField: "metadataKey",
+		},
+		{
+			name:      "metadata key too long",
+			filter:    ListFilter{Statuses: []Status{StatusSent}, MetadataKey: strings.Repeat("k", maxMetadataKeyLength+1)},
+			wantErr:   true,
+			wantField: "metadataKey",
+		},
+		{
+			name:      "negative limit",
+			filter:    ListFilter{Limit: -1},
+			wantErr:   true,
+			wantField: "limit",
+		},
+		{
+			name:      "limit too large",
+			filter:    ListFilter{Limit: MaxListLimit + 1},
+			wantErr:   true,
+			wantField: "limit",
+		},
+		{
+			name:      "invalid cursor",
+			filter:    ListFilter{Cursor: "not-a-cursor"},
+			wantErr:   true,
+			wantField: "cursor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.filter.Normalize("TR")
+
+			err := tt.filter.Validate()
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+
+			if tt.wantField != "" {
+				var fieldErr *fault.FieldError
+
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.wantField, fieldErr.Field())
+			}
+		})
+	}
+}
+
+func TestListFilter_Normalize(t *testing.T) {
+	filter := ListFilter{Phone: " +905551234567 ", Query: " shipped "}
+	filter.Normalize("TR")
+
+	assert.Equal(t, "+905551234567", filter.Phone)
+	assert.Equal(t, "shipped", filter.Query)
+	assert.Equal(t, DefaultListLimit, filter.Limit)
+
+	filter = ListFilter{Phone: "0555 123 45 67"}
+	filter.Normalize("TR")
+
+	assert.Equal(t, "+905551234567", filter.Phone)
+
+	filter = ListFilter{Phone: "(202) 555-0143"}
+	filter.Normalize("US")
+
+	assert.Equal(t, "+12025550143", filter.Phone)
+
+	filter = ListFilter{Phone: "not a phone"}
+	filter.Normalize("TR")
+
+	assert.Equal(t, "not a phone", filter.Phone)
+}
+
+func TestCursor(t *testing.T) {
+	message := Message{
+		ID:        "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+		CreatedAt: time.Date(2023, 10, 27, 10, 0, 0, 123456000, time.UTC),
+	}
+
+	cursor, err := ParseCursor(NewCursor(message))
+	assert.NoError(t, err)
+	assert.Equal(t, message.ID, cursor.ID)
+	assert.True(t, message.CreatedAt.Equal(cursor.CreatedAt))
+
+	_, err = ParseCursor("eyJpIjoiaW52YWxpZCJ9")
+	assert.Error(t, err)
+}
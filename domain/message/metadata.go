@@ -0,0 +1,109 @@
+package message
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"messager/domain/fault"
+)
+
+const (
+	maxMetadataKeys        = 20
+	maxMetadataKeyLength   = 64
+	maxMetadataValueLength = 512
+	maxTags                = 20
+	maxTagLength           = 64
+)
+
+func (m *Message) validateMetadata() error {
+	if len(m.Metadata) > maxMetadataKeys {
+		return fault.NewFieldError("metadata", fault.CodeTooLong,
+			fmt.Sprintf("message metadata must not exceed %d keys", maxMetadataKeys),
+			map[string]any{"max": maxMetadataKeys})
+	}
+
+	for key, value := range m.Metadata {
+		if err := validateMetadataKey("metadata", key); err != nil {
+			return err
+		}
+
+		if utf8.RuneCountInString(value) > maxMetadataValueLength {
+			return fault.NewFieldError("metadata", fault.CodeTooLong,
+				fmt.Sprintf("message metadata value of %q must not exceed %d characters", key, maxMetadataValueLength),
+				map[string]any{"key": key, "max": maxMetadataValueLength})
+		}
+	}
+
+	return nil
+}
+
+func (m *Message) validateTags() error {
+	if len(m.Tags) > maxTags {
+		return fault.NewFieldError("tags", fault.CodeTooLong,
+			fmt.Sprintf("message tags must not exceed %d tags", maxTags),
+			map[string]any{"max": maxTags})
+	}
+
+	for _, tag := range m.Tags {
+		if err := validateTag("tags", tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeTags removes duplicate tags, keeping the first occurrence of each.
+func (m *Message) normalizeTags() {
+	tags := make([]string, 0, len(m.Tags))
+
+	for _, tag := range m.Tags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	m.Tags = tags
+}
+
+func validateMetadataKey(field, key string) error {
+	if key == "" {
+		return fault.NewFieldError(field, fault.CodeRequired, "message metadata keys must not be empty", nil)
+	}
+
+	if utf8.RuneCountInString(key) > maxMetadataKeyLength {
+		return fault.NewFieldError(field, fault.CodeTooLong,
+			fmt.Sprintf("message metadata key %q must not exceed %d characters", key, maxMetadataKeyLength),
+			map[string]any{"key": key, "max": maxMetadataKeyLength})
+	}
+
+	if strings.TrimSpace(key) != key {
+		return fault.NewFieldError(field, fault.CodeWhitespace,
+			fmt.Sprintf("message metadata key %q must not contain leading or trailing whitespace", key),
+			map[string]any{"key": key})
+	}
+
+	return nil
+}
+
+func validateTag(field, tag string) error {
+	if tag == "" {
+		return fault.NewFieldError(field, fault.CodeRequired, "message tags must not be empty", nil)
+	}
+
+	if utf8.RuneCountInString(tag) > maxTagLength {
+		return fault.NewFieldError(field, fault.CodeTooLong,
+			fmt.Sprintf("message tag %q must not exceed %d characters", tag, maxTagLength),
+			map[string]any{"tag": tag, "max": maxTagLength})
+	}
+
+	if strings.TrimSpace(tag) != tag {
+		return fault.NewFieldError(field, fault.CodeWhitespace,
+			fmt.Sprintf("message tag %q must not contain leading or trailing whitespace", tag),
+			map[string]any{"tag": tag})
+	}
+
+	return nil
+}
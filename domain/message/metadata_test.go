@@ -0,0 +1,124 @@
+package message
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+)
+
+func TestMessage_ValidateForCreate_MetadataAndTags(t *testing.T) {
+	tooManyKeys := make(map[string]string, maxMetadataKeys+1)
+	for i := range maxMetadataKeys + 1 {
+		tooManyKeys[strings.Repeat("k", i+1)] = "value"
+	}
+
+	tooManyTags := make([]string, maxTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = strings.Repeat("t", i+1)
+	}
+
+	tests := []struct {
+		name      string
+		metadata  map[string]string
+		tags      []string
+		wantField string
+		wantCode  string
+	}{
+		{
+			name:     "valid",
+			metadata: map[string]string{"orderId": "42", "campaign": ""},
+			tags:     []string{"order-update", "vip"},
+		},
+		{
+			name:      "too many metadata keys",
+			metadata:  tooManyKeys,
+			wantField: "metadata",
+			wantCode:  fault.CodeTooLong,
+		},
+		{
+			name:      "empty metadata key",
+			metadata:  map[string]string{"": "42"},
+			wantField: "metadata",
+			wantCode:  fault.CodeRequired,
+		},
+		{
+			name:      "metadata key too long",
+			metadata:  map[string]string{strings.Repeat("k", maxMetadataKeyLength+1): "42"},
+			wantField: "metadata",
+			wantCode:  fault.CodeTooLong,
+		},
+		{
+			name:      "metadata key with whitespace",
+			metadata:  map[string]string{" orderId": "42"},
+			wantField: "metadata",
+			wantCode:  fault.CodeWhitespace,
+		},
+		{
+			name:      "metadata value too long",
+			metadata:  map[string]string{"orderId": strings.Repeat("v", maxMetadataValueLength+1)},
+			wantField: "metadata",
+			wantCode:  fault.CodeTooLong,
+		},
+		{
+			name:      "too many tags",
+			tags:      tooManyTags,
+			wantField: "tags",
+			wantCode:  fault.CodeTooLong,
+		},
+		{
+			name:      "empty tag",
+			tags:      []string{"vip", ""},
+			wantField: "tags",
+			wantCode:  fault.CodeRequired,
+		},
+		{
+			name:      "tag too long",
+			tags:      []string{strings.Repeat("t", maxTagLength+1)},
+			wantField: "tags",
+			wantCode:  fault.CodeTooLong,
+		},
+		{
+			name:      "tag with whitespace",
+			tags:      []string{"vip "},
+			wantField: "tags",
+			wantCode:  fault.CodeWhitespace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := Message{
+				Content:  "This is a valid message content",
+				Phone:    "+905551234567",
+				Status:   StatusPending,
+				Metadata: tt.metadata,
+				Tags:     tt.tags,
+			}
+
+			err := message.ValidateForCreate(testCreateOptions)
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var fieldErr *fault.FieldError
+
+			assert.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tt.wantField, fieldErr.Field())
+			assert.Equal(t, tt.wantCode, fieldErr.Code())
+		})
+	}
+}
+
+func TestMessage_NormalizeForCreate_Tags(t *testing.T) {
+	message := Message{
+		Phone: "+905551234567",
+		Tags:  []string{"vip", "order-update", "vip"},
+	}
+
+	assert.NoError(t, message.NormalizeForCreate(testCreateOptions))
+	assert.Equal(t, []string{"vip", "order-update"}, message.Tags)
+}
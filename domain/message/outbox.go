@@ -0,0 +1,63 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Relay is where the relay hands the outbox entries to.
+type Relay string
+
+const (
+	RelayDirect Relay = "DIRECT"
+	RelayKafka  Relay = "KAFKA"
+)
+
+// OutboxEntry is a status change of a message, written in the same statement
+// as the change and relayed in the order of its ID.
+type OutboxEntry struct {
+	ID        int64
+	MessageID string
+	TenantID  string
+	CreatedAt time.Time
+	From      Status
+	To        Status
+}
+
+// Publisher publishes outbox entries in order. An entry is relayed again
+// when Publish fails, so consumers must tolerate duplicates.
+type Publisher interface {
+	Publish(ctx context.Context, entries []OutboxEntry) error
+	Close() error
+}
+
+// ParseRelay parses a relay name. An empty name dispatches directly.
+func ParseRelay(value string) (Relay, error) {
+	if value == "" {
+		return RelayDirect, nil
+	}
+
+	relay := Relay(strings.ToUpper(value))
+	if !relay.IsValid() {
+		return "", fmt.Errorf("outbox relay %q must be one of DIRECT or KAFKA", value)
+	}
+
+	return relay, nil
+}
+
+func (r Relay) IsValid() bool {
+	switch r {
+	case RelayDirect, RelayKafka:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDispatch reports whether the entry claims a message for sending, either
+// pending or held in QUEUED by quiet hours.
+func (e *OutboxEntry) IsDispatch() bool {
+	return (e.From == StatusPending || e.From == StatusQueued) && e.To == StatusSending
+}
@@ -0,0 +1,37 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRelay(t *testing.T) {
+	relay, err := ParseRelay("")
+	assert.NoError(t, err)
+	assert.Equal(t, RelayDirect, relay)
+
+	relay, err = ParseRelay("kafka")
+	assert.NoError(t, err)
+	assert.Equal(t, RelayKafka, relay)
+
+	_, err = ParseRelay("DEBEZIUM")
+	assert.EqualError(t, err, `outbox relay "DEBEZIUM" must be one of DIRECT or KAFKA`)
+}
+
+func TestOutboxEntry_IsDispatch(t *testing.T) {
+	entry := OutboxEntry{From: StatusPending, To: StatusSending}
+	assert.True(t, entry.IsDispatch())
+
+	entry = OutboxEntry{From: StatusQueued, To: StatusSending}
+	assert.True(t, entry.IsDispatch())
+
+	entry = OutboxEntry{From: StatusSending, To: StatusQueued}
+	assert.False(t, entry.IsDispatch())
+
+	entry = OutboxEntry{From: StatusSending, To: StatusSent}
+	assert.False(t, entry.IsDispatch())
+
+	entry = OutboxEntry{To: StatusPending}
+	assert.False(t, entry.IsDispatch())
+}
@@ -0,0 +1,130 @@
+package message
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const quietHoursOff = "off"
+
+// QuietHours is a daily window of local time in which messages are not sent.
+// The window wraps midnight when Start is after End. The zero value disables
+// quiet hours.
+type QuietHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseQuietHours parses a window formatted as "21:00-09:00". An empty value or
+// "off" disables quiet hours.
+func ParseQuietHours(value string) (QuietHours, error) {
+	if value == "" || value == quietHoursOff {
+		return QuietHours{}, nil
+	}
+
+	start, end, ok := strings.Cut(value, "-")
+	if !ok {
+		return QuietHours{}, fmt.Errorf("quiet hours %q must be formatted as HH:MM-HH:MM", value)
+	}
+
+	startTime, err := time.Parse("15:04", start)
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours %q must be formatted as HH:MM-HH:MM", value)
+	}
+
+	endTime, err := time.Parse("15:04", end)
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours %q must be formatted as HH:MM-HH:MM", value)
+	}
+
+	quietHours := QuietHours{
+		Start: time.Duration(startTime.Hour())*time.Hour + time.Duration(startTime.Minute())*time.Minute,
+		End:   time.Duration(endTime.Hour())*time.Hour + time.Duration(endTime.Minute())*time.Minute,
+	}
+
+	if quietHours.Start == quietHours.End {
+		return QuietHours{}, fmt.Errorf("quiet hours %q must not start and end at the same time", value)
+	}
+
+	return quietHours, nil
+}
+
+// ParseQuietHoursByCategory resolves the quiet hours of every category. A
+// category without its own value uses the global one, "off" exempts it.
+func ParseQuietHoursByCategory(global string, byCategory map[Category]string) (map[Category]QuietHours, error) {
+	quietHours := make(map[Category]QuietHours)
+
+	for _, category := range []Category{CategoryTransactional, CategoryMarketing} {
+		value := byCategory[category]
+		if value == "" {
+			value = global
+		}
+
+		categoryQuietHours, err := ParseQuietHours(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !categoryQuietHours.IsZero() {
+			quietHours[category] = categoryQuietHours
+		}
+	}
+
+	return quietHours, nil
+}
+
+func (q QuietHours) IsZero() bool {
+	return q.Start == q.End
+}
+
+// Until returns the end of the quiet hours t falls in, in the location of t,
+// or the zero time when t is outside of them.
+func (q QuietHours) Until(t time.Time) time.Time {
+	if q.IsZero() {
+		return time.Time{}
+	}
+
+	year, month, day := t.Date()
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+
+	end := func(day int) time.Time {
+		return time.Date(year, month, day, int(q.End/time.Hour), int(q.End%time.Hour/time.Minute), 0, 0, t.Location())
+	}
+
+	if q.Start < q.End {
+		if clock >= q.Start && clock < q.End {
+			return end(day)
+		}
+
+		return time.Time{}
+	}
+
+	if clock >= q.Start {
+		return end(day + 1)
+	}
+
+	if clock < q.End {
+		return end(day)
+	}
+
+	return time.Time{}
+}
+
+// QuietUntil returns the end of the quiet hours of the message category it
+// falls in at now, in the recipient's local time, or the zero time when it can
+// be sent. Recipients without a known time zone are treated as UTC.
+func (m *Message) QuietUntil(now time.Time, quietHours map[Category]QuietHours) time.Time {
+	categoryQuietHours, ok := quietHours[m.Category]
+	if !ok {
+		return time.Time{}
+	}
+
+	location, err := m.RecipientLocation()
+	if err != nil {
+		location = time.UTC
+	}
+
+	return categoryQuietHours.Until(now.In(location))
+}
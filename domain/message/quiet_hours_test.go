@@ -0,0 +1,131 @@
+package message
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuietHours(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    QuietHours
+		wantErr bool
+	}{
+		{name: "empty", value: "", want: QuietHours{}},
+		{name: "off", value: "off", want: QuietHours{}},
+		{name: "wrapping midnight", value: "21:00-09:00", want: QuietHours{Start: 21 * time.Hour, End: 9 * time.Hour}},
+		{name: "same day", value: "12:30-14:00", want: QuietHours{Start: 12*time.Hour + 30*time.Minute, End: 14 * time.Hour}},
+		{name: "missing end", value: "21:00", wantErr: true},
+		{name: "invalid clock", value: "25:00-09:00", wantErr: true},
+		{name: "empty window", value: "09:00-09:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuietHours(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseQuietHoursByCategory(t *testing.T) {
+	got, err := ParseQuietHoursByCategory("21:00-09:00", map[Category]string{
+		CategoryTransactional: "off",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[Category]QuietHours{
+		CategoryMarketing: {Start: 21 * time.Hour, End: 9 * time.Hour},
+	}, got)
+
+	_, err = ParseQuietHoursByCategory("", map[Category]string{CategoryMarketing: "nightly"})
+	assert.Error(t, err)
+}
+
+func TestQuietHours_Until(t *testing.T) {
+	istanbul, err := time.LoadLocation("Europe/Istanbul")
+	assert.NoError(t, err)
+
+	night := QuietHours{Start: 21 * time.Hour, End: 9 * time.Hour}
+	noon := QuietHours{Start: 12 * time.Hour, End: 14 * time.Hour}
+
+	tests := []struct {
+		name       string
+		quietHours QuietHours
+		t          time.Time
+		want       time.Time
+	}{
+		{
+			name:       "before wrapping window",
+			quietHours: night,
+			t:          time.Date(2025, 3, 10, 20, 59, 0, 0, istanbul),
+			want:       time.Time{},
+		},
+		{
+			name:       "evening in wrapping window",
+			quietHours: night,
+			t:          time.Date(2025, 3, 10, 22, 0, 0, 0, istanbul),
+			want:       time.Date(2025, 3, 11, 9, 0, 0, 0, istanbul),
+		},
+		{
+			name:       "morning in wrapping window",
+			quietHours: night,
+			t:          time.Date(2025, 3, 11, 8, 30, 0, 0, istanbul),
+			want:       time.Date(2025, 3, 11, 9, 0, 0, 0, istanbul),
+		},
+		{
+			name:       "end of wrapping window",
+			quietHours: night,
+			t:          time.Date(2025, 3, 11, 9, 0, 0, 0, istanbul),
+			want:       time.Time{},
+		},
+		{
+			name:       "last day of month",
+			quietHours: night,
+			t:          time.Date(2025, 3, 31, 23, 0, 0, 0, istanbul),
+			want:       time.Date(2025, 4, 1, 9, 0, 0, 0, istanbul),
+		},
+		{
+			name:       "in same day window",
+			quietHours: noon,
+			t:          time.Date(2025, 3, 10, 13, 0, 0, 0, istanbul),
+			want:       time.Date(2025, 3, 10, 14, 0, 0, 0, istanbul),
+		},
+		{
+			name:       "disabled",
+			quietHours: QuietHours{},
+			t:          time.Date(2025, 3, 10, 23, 0, 0, 0, istanbul),
+			want:       time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quietHours.Until(tt.t))
+		})
+	}
+}
+
+func TestMessage_QuietUntil(t *testing.T) {
+	quietHours := map[Category]QuietHours{
+		CategoryMarketing: {Start: 21 * time.Hour, End: 9 * time.Hour},
+	}
+	// 20:00 UTC is 23:00 in Istanbul and 15:00 in New York.
+	now := time.Date(2025, 3, 10, 20, 0, 0, 0, time.UTC)
+
+	turkish := Message{Phone: "+905551234567", Region: "TR", Category: CategoryMarketing}
+	assert.True(t, turkish.QuietUntil(now, quietHours).Equal(time.Date(2025, 3, 11, 6, 0, 0, 0, time.UTC)))
+
+	american := Message{Phone: "+12125551234", Region: "US", Category: CategoryMarketing}
+	assert.True(t, american.QuietUntil(now, quietHours).IsZero())
+
+	transactional := Message{Phone: "+905551234567", Region: "TR", Category: CategoryTransactional}
+	assert.True(t, transactional.QuietUntil(now, quietHours).IsZero())
+}
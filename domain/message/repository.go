@@ -2,12 +2,26 @@ package message
 
 import (
 	"context"
+	"time"
 )
 
 type Repository interface {
 	Create(ctx context.Context, message *Message) error
-	FindAllByStatus(ctx context.Context, status Status) ([]Message, error)
-	FindByID(ctx context.Context, id string) (*Message, error)
-	UpdateAllStatusesByStatus(ctx context.Context, from, to Status) error
-	CreateSentInfo(ctx context.Context, messageID, time string) error
+	CreateAll(ctx context.Context, messages []*Message) error
+	FindAllByStatus(ctx context.Context, filter ListFilter) (*Page, error)
+	FindByID(ctx context.Context, tenantID, id string) (*Message, error)
+	ClaimAllByStatusAndPriority(ctx context.Context, from, to Status, priority Priority, limit int) ([]Message, error)
+	UpdateStatus(ctx context.Context, tenantID, id string, from, to Status) error
+	Reschedule(ctx context.Context, tenantID, id string, from Status, sendAt time.Time) error
+	ExpireAllByStatus(ctx context.Context, status Status) error
+	RequeueAllByStatus(ctx context.Context, status Status, updatedBefore time.Time) error
+	CreateAttempt(ctx context.Context, attempt *Attempt) error
+	FindAllAttemptsByMessageID(ctx context.Context, tenantID, messageID string) ([]Attempt, error)
+	FindAllEventsByMessageID(ctx context.Context, tenantID, messageID string) ([]Event, error)
+	ReserveIdempotencyKey(ctx context.Context, record IdempotencyRecord, ttl time.Duration) (*IdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, record IdempotencyRecord, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, tenantID, key string) error
+	ReserveQuota(ctx context.Context, tenantID string, day time.Time, count, limit int) (bool, error)
+	ReleaseQuota(ctx context.Context, tenantID string, day time.Time, count int) error
+	RelayOutbox(ctx context.Context, limit int, relay func(entries []OutboxEntry) error) error
 }
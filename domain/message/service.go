@@ -4,7 +4,14 @@ import "context"
 
 type Service interface {
 	Create(ctx context.Context, message Message) (*Message, error)
-	ListByStatus(ctx context.Context, status Status) ([]Message, error)
+	CreateBatch(ctx context.Context, messages []Message) ([]CreateResult, error)
+	ReleaseQuota(ctx context.Context, count int) error
+	Get(ctx context.Context, id string) (*Message, error)
+	ListByStatus(ctx context.Context, filter ListFilter) (*Page, error)
+	ListEvents(ctx context.Context, id string) ([]Event, error)
 	Process(ctx context.Context) error
+	Expire(ctx context.Context) error
 	Sent(ctx context.Context, message Message) error
+	Relay(ctx context.Context) error
+	Dispatch(ctx context.Context, entries []OutboxEntry) error
 }
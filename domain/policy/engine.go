@@ -0,0 +1,298 @@
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"messager/domain/fault"
+)
+
+const (
+	RuleForbiddenWord    Rule = "FORBIDDEN_WORD"
+	RuleForbiddenPattern Rule = "FORBIDDEN_PATTERN"
+	RuleDomainNotAllowed Rule = "DOMAIN_NOT_ALLOWED"
+	RuleRequiredFooter   Rule = "REQUIRED_FOOTER"
+	RuleMaxLinks         Rule = "MAX_LINKS"
+)
+
+var ErrContentViolatesPolicy = fault.New("CONTENT_VIOLATES_POLICY", "content violates policy")
+
+// link matches the URLs a recipient can follow: with a scheme or starting
+// with www.
+var link = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+
+type Rule string
+
+// Violation is a rule of a policy the content does not satisfy.
+type Violation struct {
+	Policy string
+	Rule   Rule
+	Reason string
+}
+
+type ViolationError struct {
+	Violations []Violation
+}
+
+// Engine evaluates content against the rules of a set of policies.
+type Engine struct {
+	rules []rule
+}
+
+// rule is a single content check. New kinds of rules are added by
+// implementing it and building them in NewEngine.
+type rule interface {
+	evaluate(content string, links []string) []Violation
+}
+
+type forbiddenWordRule struct {
+	policy  string
+	word    string
+	pattern *regexp.Regexp
+}
+
+type forbiddenPatternRule struct {
+	policy  string
+	pattern *regexp.Regexp
+}
+
+type allowedDomainsRule struct {
+	policy  string
+	domains []string
+}
+
+type requiredFooterRule struct {
+	policy string
+	footer string
+}
+
+type maxLinksRule struct {
+	policy   string
+	maxLinks int
+}
+
+func NewEngine(policies ...Policy) (*Engine, error) {
+	var engine Engine
+
+	for _, policy := range policies {
+		for _, word := range policy.ForbiddenWords {
+			pattern, err := regexp.Compile(`(?i)(?:^|[^\p{L}\p{N}_])` + regexp.QuoteMeta(word) + `(?:$|[^\p{L}\p{N}_])`)
+			if err != nil {
+				return nil, fmt.Errorf("regexp.Compile(): %w", err)
+			}
+
+			engine.rules = append(engine.rules, &forbiddenWordRule{policy: policy.Name, word: word, pattern: pattern})
+		}
+
+		for _, forbiddenPattern := range policy.ForbiddenPatterns {
+			pattern, err := regexp.Compile(forbiddenPattern)
+			if err != nil {
+				return nil, fmt.Errorf("regexp.Compile(): %w", err)
+			}
+
+			engine.rules = append(engine.rules, &forbiddenPatternRule{policy: policy.Name, pattern: pattern})
+		}
+
+		if len(policy.AllowedDomains) > 0 {
+			engine.rules = append(engine.rules, &allowedDomainsRule{policy: policy.Name, domains: policy.AllowedDomains})
+		}
+
+		if policy.RequiredFooter != "" {
+			engine.rules = append(engine.rules, &requiredFooterRule{policy: policy.Name, footer: policy.RequiredFooter})
+		}
+
+		if policy.MaxLinks > 0 {
+			engine.rules = append(engine.rules, &maxLinksRule{policy: policy.Name, maxLinks: policy.MaxLinks})
+		}
+	}
+
+	return &engine, nil
+}
+
+// Evaluate returns the violations of the content, or nil when it satisfies
+// every rule. Parts are the other texts sent with the content, such as the
+// subject and HTML body of an email. They are checked against every rule but
+// the required footer, which only the content must end with, and a violation
+// found in several of them is returned once.
+func (e *Engine) Evaluate(content string, parts ...string) []Violation {
+	if len(e.rules) == 0 {
+		return nil
+	}
+
+	links := link.FindAllString(content, -1)
+
+	var violations []Violation
+
+	for _, rule := range e.rules {
+		violations = append(violations, rule.evaluate(content, links)...)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		links := link.FindAllString(part, -1)
+
+		for _, rule := range e.rules {
+			if _, ok := rule.(*requiredFooterRule); ok {
+				continue
+			}
+
+			for _, violation := range rule.evaluate(part, links) {
+				if !slices.Contains(violations, violation) {
+					violations = append(violations, violation)
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+func (r *forbiddenWordRule) evaluate(content string, _ []string) []Violation {
+	if !r.pattern.MatchString(content) {
+		return nil
+	}
+
+	return []Violation{{
+		Policy: r.policy,
+		Rule:   RuleForbiddenWord,
+		Reason: fmt.Sprintf("content must not contain %q", r.word),
+	}}
+}
+
+func (r *forbiddenPatternRule) evaluate(content string, _ []string) []Violation {
+	if !r.pattern.MatchString(content) {
+		return nil
+	}
+
+	return []Violation{{
+		Policy: r.policy,
+		Rule:   RuleForbiddenPattern,
+		Reason: fmt.Sprintf("content must not match %q", r.pattern.String()),
+	}}
+}
+
+func (r *allowedDomainsRule) evaluate(_ string, links []string) []Violation {
+	var violations []Violation
+
+	for _, link := range links {
+		host := linkHost(link)
+
+		if !r.allows(host) {
+			violations = append(violations, Violation{
+				Policy: r.policy,
+				Rule:   RuleDomainNotAllowed,
+				Reason: fmt.Sprintf("content must not link to %s", host),
+			})
+		}
+	}
+
+	return violations
+}
+
+func (r *allowedDomainsRule) allows(host string) bool {
+	for _, domain := range r.domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *requiredFooterRule) evaluate(content string, _ []string) []Violation {
+	if strings.HasSuffix(strings.TrimSpace(content), r.footer) {
+		return nil
+	}
+
+	return []Violation{{
+		Policy: r.policy,
+		Rule:   RuleRequiredFooter,
+		Reason: fmt.Sprintf("content must end with %q", r.footer),
+	}}
+}
+
+func (r *maxLinksRule) evaluate(_ string, links []string) []Violation {
+	if len(links) <= r.maxLinks {
+		return nil
+	}
+
+	return []Violation{{
+		Policy: r.policy,
+		Rule:   RuleMaxLinks,
+		Reason: fmt.Sprintf("content must not contain more than %d links", r.maxLinks),
+	}}
+}
+
+// linkHost returns the lower cased host of a link matched in content.
+func linkHost(link string) string {
+	host := strings.ToLower(link)
+
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+len("://"):]
+	}
+
+	if i := strings.IndexAny(host, "/?#"); i >= 0 {
+		host = host[:i]
+	}
+
+	if i := strings.LastIndex(host, "@"); i >= 0 {
+		host = host[i+1:]
+	}
+
+	if i := strings.Index(host, ":"); i >= 0 {
+		host = host[:i]
+	}
+
+	return strings.TrimRight(host, ".,;!?)")
+}
+
+func (e *ViolationError) Error() string {
+	reasons := make([]string, 0, len(e.Violations))
+
+	for _, violation := range e.Violations {
+		reasons = append(reasons, violation.Reason)
+	}
+
+	return fmt.Sprintf("content violates policy: %s", strings.Join(reasons, "; "))
+}
+
+func (e *ViolationError) Is(target error) bool {
+	return target == ErrContentViolatesPolicy
+}
+
+func (e *ViolationError) Code() string {
+	return ErrContentViolatesPolicy.Code()
+}
+
+// Unwrap returns the violations as errors, so that each of them is reported
+// as an error of the content field.
+func (e *ViolationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Violations))
+
+	for _, violation := range e.Violations {
+		errs = append(errs, violation)
+	}
+
+	return errs
+}
+
+func (v Violation) Error() string {
+	return v.Reason
+}
+
+func (v Violation) Field() string {
+	return "content"
+}
+
+func (v Violation) Code() string {
+	return string(v.Rule)
+}
+
+func (v Violation) Params() map[string]any {
+	return map[string]any{"policy": v.Policy}
+}
@@ -0,0 +1,118 @@
+package policy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngine_Evaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		content string
+		parts   []string
+		want    []Rule
+	}{
+		{
+			name:    "forbidden word",
+			policy:  Policy{ForbiddenWords: []string{"free money"}},
+			content: "Claim your FREE MONEY today",
+			want:    []Rule{RuleForbiddenWord},
+		},
+		{
+			name:    "forbidden word inside another word",
+			policy:  Policy{ForbiddenWords: []string{"win"}},
+			content: "Our winter collection is here",
+			want:    nil,
+		},
+		{
+			name:    "forbidden pattern",
+			policy:  Policy{ForbiddenPatterns: []string{`\b\d{16}\b`}},
+			content: "Your card 4111111111111111 was charged",
+			want:    []Rule{RuleForbiddenPattern},
+		},
+		{
+			name:    "allowed domain",
+			policy:  Policy{AllowedDomains: []string{"example.com"}},
+			content: "See https://shop.example.com/sale.",
+			want:    nil,
+		},
+		{
+			name:    "domain not allowed",
+			policy:  Policy{AllowedDomains: []string{"example.com"}},
+			content: "See https://example.com.evil.io/sale and www.bit.ly/x",
+			want:    []Rule{RuleDomainNotAllowed, RuleDomainNotAllowed},
+		},
+		{
+			name:    "required footer",
+			policy:  Policy{RequiredFooter: "Reply STOP to opt out."},
+			content: "Everything is 50% off today! Reply STOP to opt out. ",
+			want:    nil,
+		},
+		{
+			name:    "required footer missing",
+			policy:  Policy{RequiredFooter: "Reply STOP to opt out."},
+			content: "Everything is 50% off today!",
+			want:    []Rule{RuleRequiredFooter},
+		},
+		{
+			name:    "too many links",
+			policy:  Policy{MaxLinks: 1},
+			content: "See https://example.com and https://example.org",
+			want:    []Rule{RuleMaxLinks},
+		},
+		{
+			name:    "forbidden word in a part",
+			policy:  Policy{ForbiddenWords: []string{"free money"}},
+			content: "Our offers this week",
+			parts:   []string{"Free money inside", `<p>Claim your <b>free money</b></p>`},
+			want:    []Rule{RuleForbiddenWord},
+		},
+		{
+			name:    "domain not allowed in a part",
+			policy:  Policy{AllowedDomains: []string{"example.com"}},
+			content: "See our sale",
+			parts:   []string{`<a href="https://example.org/sale">sale</a>`},
+			want:    []Rule{RuleDomainNotAllowed},
+		},
+		{
+			name:    "parts need no footer",
+			policy:  Policy{RequiredFooter: "Reply STOP to opt out."},
+			content: "Our sale starts today. Reply STOP to opt out.",
+			parts:   []string{"Sale", "<p>Our sale starts today.</p>"},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.policy.Name = "test"
+
+			engine, err := NewEngine(tt.policy)
+			assert.NoError(t, err)
+
+			var rules []Rule
+
+			for _, violation := range engine.Evaluate(tt.content, tt.parts...) {
+				assert.Equal(t, "test", violation.Policy)
+				rules = append(rules, violation.Rule)
+			}
+
+			assert.Equal(t, tt.want, rules)
+		})
+	}
+}
+
+func TestViolationError(t *testing.T) {
+	err := error(&ViolationError{Violations: []Violation{
+		{Policy: "marketing", Rule: RuleMaxLinks, Reason: "content must not contain more than 1 links"},
+		{Policy: "marketing", Rule: RuleRequiredFooter, Reason: `content must end with "STOP"`},
+	}})
+
+	assert.True(t, errors.Is(err, ErrContentViolatesPolicy))
+	assert.Equal(t, "CONTENT_VIOLATES_POLICY", err.(*ViolationError).Code())
+	assert.Len(t, err.(*ViolationError).Unwrap(), 2)
+	assert.Equal(t, `content violates policy: content must not contain more than 1 links; content must end with "STOP"`, err.Error())
+}
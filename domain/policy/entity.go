@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"messager/domain/fault"
+)
+
+const (
+	maxNameLength = 255
+)
+
+var (
+	ErrPolicyDoesNotValidForCreate = fault.New("POLICY_INVALID_FOR_CREATE", "policy does not valid for create")
+	ErrPolicyDoesNotValidForFind   = fault.New("POLICY_INVALID_FOR_FIND", "policy does not valid for find")
+	ErrPolicyNotFound              = fault.New("POLICY_NOT_FOUND", "policy not found")
+)
+
+// Policy is a set of content rules every message must satisfy. Empty rules
+// are not enforced.
+type Policy struct {
+	ID                string
+	TenantID          string
+	CreatedAt         time.Time
+	Name              string
+	ForbiddenWords    []string
+	ForbiddenPatterns []string
+	AllowedDomains    []string
+	RequiredFooter    string
+	MaxLinks          int
+}
+
+func (p *Policy) NewErrPolicyDoesNotValidForCreate() error {
+	return ErrPolicyDoesNotValidForCreate
+}
+
+func (p *Policy) NewErrPolicyDoesNotValidForFind() error {
+	return ErrPolicyDoesNotValidForFind
+}
+
+func (p *Policy) NewErrPolicyNotFound() error {
+	return ErrPolicyNotFound
+}
+
+func (p *Policy) ValidateForCreate() error {
+	if p.Name == "" {
+		return fault.NewFieldError("name", fault.CodeRequired, "policy name must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(p.Name) > maxNameLength {
+		return fault.NewFieldError("name", fault.CodeTooLong,
+			fmt.Sprintf("policy name must not exceed %d characters", maxNameLength),
+			map[string]any{"max": maxNameLength})
+	}
+
+	if strings.TrimSpace(p.Name) != p.Name {
+		return fault.NewFieldError("name", fault.CodeWhitespace, "policy name must not contain leading or trailing whitespace", nil)
+	}
+
+	return p.ValidateRules()
+}
+
+// ValidateRules validates the rules of a policy regardless of where it is
+// loaded from.
+func (p *Policy) ValidateRules() error {
+	if p.IsEmpty() {
+		return fault.NewFieldError("rules", fault.CodeRequired, "policy must have at least one rule", nil)
+	}
+
+	for _, word := range p.ForbiddenWords {
+		if strings.TrimSpace(word) == "" {
+			return fault.NewFieldError("forbiddenWords", fault.CodeRequired, "policy forbidden words must not be empty", nil)
+		}
+	}
+
+	for _, pattern := range p.ForbiddenPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fault.NewFieldError("forbiddenPatterns", fault.CodeInvalid,
+				fmt.Sprintf("policy forbidden pattern %q must be a valid regular expression: %v", pattern, err),
+				map[string]any{"pattern": pattern})
+		}
+	}
+
+	for _, domain := range p.AllowedDomains {
+		if domain == "" || strings.ContainsAny(domain, "/: ") {
+			return fault.NewFieldError("allowedDomains", fault.CodeInvalid,
+				fmt.Sprintf("policy allowed domain %q must be a host name", domain),
+				map[string]any{"domain": domain})
+		}
+	}
+
+	if p.MaxLinks < 0 {
+		return fault.NewFieldError("maxLinks", fault.CodeInvalid, "policy max links must not be negative",
+			map[string]any{"min": 0})
+	}
+
+	return nil
+}
+
+func (p *Policy) ValidateForFind() error {
+	if p.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "policy id must be provided", nil)
+	}
+
+	if err := uuid.Validate(p.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("policy id must be a valid uuid: %v", err), nil)
+	}
+
+	return nil
+}
+
+func (p *Policy) Normalize() {
+	for i := range p.ForbiddenWords {
+		p.ForbiddenWords[i] = strings.TrimSpace(p.ForbiddenWords[i])
+	}
+
+	for i := range p.AllowedDomains {
+		p.AllowedDomains[i] = strings.ToLower(strings.TrimPrefix(p.AllowedDomains[i], "."))
+	}
+
+	p.RequiredFooter = strings.TrimSpace(p.RequiredFooter)
+}
+
+// IsEmpty reports whether the policy has no rule to enforce.
+func (p *Policy) IsEmpty() bool {
+	return len(p.ForbiddenWords) == 0 && len(p.ForbiddenPatterns) == 0 && len(p.AllowedDomains) == 0 &&
+		p.RequiredFooter == "" && p.MaxLinks == 0
+}
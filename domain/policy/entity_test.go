@@ -0,0 +1,89 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+)
+
+func TestPolicy_ValidateForCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid policy",
+			policy:  Policy{Name: "marketing", ForbiddenWords: []string{"free money"}, MaxLinks: 1},
+			wantErr: false,
+		},
+		{
+			name:    "empty name",
+			policy:  Policy{ForbiddenWords: []string{"free money"}},
+			wantErr: true,
+			errMsg:  "policy name must be provided",
+		},
+		{
+			name:    "no rules",
+			policy:  Policy{Name: "marketing"},
+			wantErr: true,
+			errMsg:  "policy must have at least one rule",
+		},
+		{
+			name:    "blank forbidden word",
+			policy:  Policy{Name: "marketing", ForbiddenWords: []string{" "}},
+			wantErr: true,
+			errMsg:  "policy forbidden words must not be empty",
+		},
+		{
+			name:    "invalid pattern",
+			policy:  Policy{Name: "marketing", ForbiddenPatterns: []string{"(unclosed"}},
+			wantErr: true,
+			errMsg:  "policy forbidden pattern \"(unclosed\" must be a valid regular expression: error parsing regexp: missing closing ): `(unclosed`",
+		},
+		{
+			name:    "url as allowed domain",
+			policy:  Policy{Name: "marketing", AllowedDomains: []string{"https://example.com"}},
+			wantErr: true,
+			errMsg:  "policy allowed domain \"https://example.com\" must be a host name",
+		},
+		{
+			name:    "negative max links",
+			policy:  Policy{Name: "marketing", RequiredFooter: "Reply STOP to opt out.", MaxLinks: -1},
+			wantErr: true,
+			errMsg:  "policy max links must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.ValidateForCreate()
+			if tt.wantErr {
+				var fieldErr *fault.FieldError
+
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicy_Normalize(t *testing.T) {
+	policy := Policy{
+		Name:           "marketing",
+		ForbiddenWords: []string{" free money "},
+		AllowedDomains: []string{".Example.COM"},
+		RequiredFooter: " Reply STOP to opt out. ",
+	}
+
+	policy.Normalize()
+
+	assert.Equal(t, []string{"free money"}, policy.ForbiddenWords)
+	assert.Equal(t, []string{"example.com"}, policy.AllowedDomains)
+	assert.Equal(t, "Reply STOP to opt out.", policy.RequiredFooter)
+}
@@ -0,0 +1,11 @@
+package policy
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, policy *Policy) error
+	FindAll(ctx context.Context, tenantID string) ([]Policy, error)
+	Delete(ctx context.Context, tenantID, id string) error
+}
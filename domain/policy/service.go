@@ -0,0 +1,9 @@
+package policy
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, policy Policy) (*Policy, error)
+	List(ctx context.Context) ([]Policy, error)
+	Delete(ctx context.Context, id string) error
+}
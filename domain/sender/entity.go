@@ -0,0 +1,179 @@
+package sender
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/nyaruka/phonenumbers"
+
+	"messager/domain/fault"
+)
+
+const (
+	TypeNumber       Type = "NUMBER"
+	TypeAlphanumeric Type = "ALPHANUMERIC"
+
+	maxAlphanumericLength = 11
+)
+
+var (
+	ErrSenderAlreadyExists         = fault.New("SENDER_ALREADY_EXISTS", "sender already exists")
+	ErrSenderDoesNotValidForCreate = fault.New("SENDER_INVALID_FOR_CREATE", "sender does not valid for create")
+	ErrSenderDoesNotValidForFind   = fault.New("SENDER_INVALID_FOR_FIND", "sender does not valid for find")
+	ErrSenderNotFound              = fault.New("SENDER_NOT_FOUND", "sender not found")
+)
+
+// Sender is a number or an alphanumeric ID messages are sent from. A sender
+// with countries is only used for recipients in them.
+type Sender struct {
+	ID        string
+	TenantID  string
+	CreatedAt time.Time
+	Type      Type
+	Value     string
+	Countries []string
+}
+
+type Type string
+
+func (s *Sender) NewErrSenderAlreadyExists() error {
+	return ErrSenderAlreadyExists
+}
+
+func (s *Sender) NewErrSenderDoesNotValidForCreate() error {
+	return ErrSenderDoesNotValidForCreate
+}
+
+func (s *Sender) NewErrSenderDoesNotValidForFind() error {
+	return ErrSenderDoesNotValidForFind
+}
+
+func (s *Sender) NewErrSenderNotFound() error {
+	return ErrSenderNotFound
+}
+
+func (s *Sender) ValidateForCreate(defaultRegion string) error {
+	if s.Type == "" {
+		return fault.NewFieldError("type", fault.CodeRequired, "sender type must be provided", nil)
+	}
+
+	if !s.Type.IsValid() {
+		return fault.NewFieldError("type", fault.CodeNotOneOf, "sender type must be one of NUMBER or ALPHANUMERIC",
+			map[string]any{"allowed": []Type{TypeNumber, TypeAlphanumeric}})
+	}
+
+	if s.Value == "" {
+		return fault.NewFieldError("value", fault.CodeRequired, "sender value must be provided", nil)
+	}
+
+	if strings.TrimSpace(s.Value) != s.Value {
+		return fault.NewFieldError("value", fault.CodeWhitespace, "sender value must not contain leading or trailing whitespace", nil)
+	}
+
+	if s.Type == TypeNumber {
+		if number, err := phonenumbers.Parse(s.Value, defaultRegion); err != nil || !phonenumbers.IsValidNumber(number) {
+			return fault.NewFieldError("value", fault.CodeInvalid, "sender value must be a valid phone number", nil)
+		}
+	}
+
+	if s.Type == TypeAlphanumeric {
+		if len(s.Value) > maxAlphanumericLength {
+			return fault.NewFieldError("value", fault.CodeTooLong,
+				fmt.Sprintf("sender value must not exceed %d characters", maxAlphanumericLength),
+				map[string]any{"max": maxAlphanumericLength})
+		}
+
+		if strings.IndexFunc(s.Value, func(r rune) bool { return r > unicode.MaxASCII || !isAlphanumeric(r) }) >= 0 {
+			return fault.NewFieldError("value", fault.CodeInvalid, "sender value must contain only ASCII letters, digits and spaces", nil)
+		}
+
+		if strings.IndexFunc(s.Value, unicode.IsLetter) < 0 {
+			return fault.NewFieldError("value", fault.CodeInvalid, "sender value must contain at least one letter", nil)
+		}
+	}
+
+	for _, country := range s.Countries {
+		if phonenumbers.GetCountryCodeForRegion(strings.ToUpper(country)) == 0 {
+			return fault.NewFieldError("countries", fault.CodeInvalid,
+				fmt.Sprintf("sender country %q must be a valid ISO 3166-1 alpha-2 region", country),
+				map[string]any{"country": country})
+		}
+	}
+
+	return nil
+}
+
+func (s *Sender) ValidateForFind() error {
+	if s.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "sender id must be provided", nil)
+	}
+
+	if err := uuid.Validate(s.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("sender id must be a valid uuid: %v", err), nil)
+	}
+
+	return nil
+}
+
+// NormalizeForCreate formats a number sender in E.164 and the countries in
+// upper case, so that they match the phone and region of messages.
+func (s *Sender) NormalizeForCreate(defaultRegion string) error {
+	if s.Type == TypeNumber {
+		number, err := phonenumbers.Parse(s.Value, defaultRegion)
+		if err != nil {
+			return fmt.Errorf("phonenumbers.Parse(): %w", err)
+		}
+
+		s.Value = phonenumbers.Format(number, phonenumbers.E164)
+	}
+
+	for i := range s.Countries {
+		s.Countries[i] = strings.ToUpper(s.Countries[i])
+	}
+
+	slices.Sort(s.Countries)
+	s.Countries = slices.Compact(s.Countries)
+
+	return nil
+}
+
+// Matches reports whether value names the sender. Numbers match in any format
+// they can be parsed from.
+func (s *Sender) Matches(value, defaultRegion string) bool {
+	if s.Value == value {
+		return true
+	}
+
+	if s.Type != TypeNumber {
+		return false
+	}
+
+	number, err := phonenumbers.Parse(value, defaultRegion)
+	if err != nil {
+		return false
+	}
+
+	return phonenumbers.Format(number, phonenumbers.E164) == s.Value
+}
+
+// Allows reports whether the sender may be used for a recipient in region.
+func (s *Sender) Allows(region string) bool {
+	return len(s.Countries) == 0 || slices.Contains(s.Countries, region)
+}
+
+func (t Type) IsValid() bool {
+	switch t {
+	case TypeNumber, TypeAlphanumeric:
+		return true
+	default:
+		return false
+	}
+}
+
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' '
+}
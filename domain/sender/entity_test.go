@@ -0,0 +1,116 @@
+package sender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+)
+
+func TestSender_ValidateForCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sender  Sender
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid number",
+			sender:  Sender{Type: TypeNumber, Value: "+905551234567", Countries: []string{"TR"}},
+			wantErr: false,
+		},
+		{
+			name:    "national number",
+			sender:  Sender{Type: TypeNumber, Value: "0555 123 45 67"},
+			wantErr: false,
+		},
+		{
+			name:    "valid alphanumeric",
+			sender:  Sender{Type: TypeAlphanumeric, Value: "Shop 24", Countries: []string{"gb", "TR"}},
+			wantErr: false,
+		},
+		{
+			name:    "empty type",
+			sender:  Sender{Value: "+905551234567"},
+			wantErr: true,
+			errMsg:  "sender type must be provided",
+		},
+		{
+			name:    "invalid type",
+			sender:  Sender{Type: "SHORT_CODE", Value: "12345"},
+			wantErr: true,
+			errMsg:  "sender type must be one of NUMBER or ALPHANUMERIC",
+		},
+		{
+			name:    "empty value",
+			sender:  Sender{Type: TypeNumber},
+			wantErr: true,
+			errMsg:  "sender value must be provided",
+		},
+		{
+			name:    "invalid number",
+			sender:  Sender{Type: TypeNumber, Value: "12345"},
+			wantErr: true,
+			errMsg:  "sender value must be a valid phone number",
+		},
+		{
+			name:    "alphanumeric too long",
+			sender:  Sender{Type: TypeAlphanumeric, Value: "VeryLongShopName"},
+			wantErr: true,
+			errMsg:  "sender value must not exceed 11 characters",
+		},
+		{
+			name:    "alphanumeric with symbols",
+			sender:  Sender{Type: TypeAlphanumeric, Value: "Shop!"},
+			wantErr: true,
+			errMsg:  "sender value must contain only ASCII letters, digits and spaces",
+		},
+		{
+			name:    "alphanumeric without letters",
+			sender:  Sender{Type: TypeAlphanumeric, Value: "12345"},
+			wantErr: true,
+			errMsg:  "sender value must contain at least one letter",
+		},
+		{
+			name:    "invalid country",
+			sender:  Sender{Type: TypeAlphanumeric, Value: "Shop", Countries: []string{"XX"}},
+			wantErr: true,
+			errMsg:  `sender country "XX" must be a valid ISO 3166-1 alpha-2 region`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sender.ValidateForCreate("TR")
+			if tt.wantErr {
+				var fieldErr *fault.FieldError
+
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSender_NormalizeForCreate(t *testing.T) {
+	sender := Sender{Type: TypeNumber, Value: "0555 123 45 67", Countries: []string{"tr", "GB", "TR"}}
+
+	err := sender.NormalizeForCreate("TR")
+	assert.NoError(t, err)
+	assert.Equal(t, "+905551234567", sender.Value)
+	assert.Equal(t, []string{"GB", "TR"}, sender.Countries)
+}
+
+func TestSender_Matches(t *testing.T) {
+	number := Sender{Type: TypeNumber, Value: "+905551234567"}
+	alphanumeric := Sender{Type: TypeAlphanumeric, Value: "Shop"}
+
+	assert.True(t, number.Matches("+905551234567", "TR"))
+	assert.True(t, number.Matches("0555 123 45 67", "TR"))
+	assert.False(t, number.Matches("0555 123 45 68", "TR"))
+	assert.True(t, alphanumeric.Matches("Shop", "TR"))
+	assert.False(t, alphanumeric.Matches("shop", "TR"))
+}
@@ -0,0 +1,11 @@
+package sender
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, sender *Sender) error
+	FindAll(ctx context.Context, tenantID string) ([]Sender, error)
+	Delete(ctx context.Context, tenantID, id string) error
+}
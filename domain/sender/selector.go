@@ -0,0 +1,111 @@
+package sender
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	StrategyCountry    Strategy = "COUNTRY"
+	StrategyRoundRobin Strategy = "ROUND_ROBIN"
+	StrategySticky     Strategy = "STICKY"
+)
+
+type Strategy string
+
+// Selector picks the sender of a message from the pool. Only senders allowed
+// for the recipient's region are considered, whatever the strategy:
+//
+//   - COUNTRY prefers senders restricted to the region over unrestricted ones,
+//     in the order of the pool.
+//   - ROUND_ROBIN rotates through the senders.
+//   - STICKY sends every message of a recipient from the same sender, and only
+//     moves the recipients of a sender when it leaves the pool.
+type Selector struct {
+	strategy Strategy
+	counter  atomic.Uint64
+}
+
+// ParseStrategy parses a strategy name. An empty name selects by country.
+func ParseStrategy(value string) (Strategy, error) {
+	if value == "" {
+		return StrategyCountry, nil
+	}
+
+	strategy := Strategy(strings.ToUpper(value))
+	if !strategy.IsValid() {
+		return "", fmt.Errorf("sender strategy %q must be one of COUNTRY, ROUND_ROBIN or STICKY", value)
+	}
+
+	return strategy, nil
+}
+
+func NewSelector(strategy Strategy) *Selector {
+	return &Selector{
+		strategy: strategy,
+	}
+}
+
+// Select returns the sender of a message to phone in region, or false when no
+// sender of the pool is allowed for the region.
+func (s *Selector) Select(senders []Sender, phone, region string) (Sender, bool) {
+	eligible := make([]Sender, 0, len(senders))
+
+	for _, sender := range senders {
+		if sender.Allows(region) {
+			eligible = append(eligible, sender)
+		}
+	}
+
+	if len(eligible) == 0 {
+		return Sender{}, false
+	}
+
+	switch s.strategy {
+	case StrategyRoundRobin:
+		return eligible[(s.counter.Add(1)-1)%uint64(len(eligible))], true
+	case StrategySticky:
+		return sticky(eligible, phone), true
+	default:
+		for _, sender := range eligible {
+			if len(sender.Countries) > 0 {
+				return sender, true
+			}
+		}
+
+		return eligible[0], true
+	}
+}
+
+// sticky picks the sender with the highest hash of it and the phone, so that
+// a phone keeps its sender as long as the sender stays in the pool.
+func sticky(senders []Sender, phone string) Sender {
+	var (
+		selected Sender
+		highest  uint64
+	)
+
+	for i, sender := range senders {
+		hash := fnv.New64a()
+		hash.Write([]byte(phone))
+		hash.Write([]byte{0})
+		hash.Write([]byte(sender.Value))
+
+		if sum := hash.Sum64(); i == 0 || sum > highest {
+			selected, highest = sender, sum
+		}
+	}
+
+	return selected
+}
+
+func (s Strategy) IsValid() bool {
+	switch s {
+	case StrategyCountry, StrategyRoundRobin, StrategySticky:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,84 @@
+package sender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pool = []Sender{
+	{Type: TypeAlphanumeric, Value: "Shop"},
+	{Type: TypeNumber, Value: "+447400000000", Countries: []string{"GB"}},
+	{Type: TypeNumber, Value: "+905550000000", Countries: []string{"TR"}},
+	{Type: TypeNumber, Value: "+905550000001", Countries: []string{"TR"}},
+}
+
+func TestParseStrategy(t *testing.T) {
+	strategy, err := ParseStrategy("")
+	assert.NoError(t, err)
+	assert.Equal(t, StrategyCountry, strategy)
+
+	strategy, err = ParseStrategy("round_robin")
+	assert.NoError(t, err)
+	assert.Equal(t, StrategyRoundRobin, strategy)
+
+	_, err = ParseStrategy("RANDOM")
+	assert.EqualError(t, err, `sender strategy "RANDOM" must be one of COUNTRY, ROUND_ROBIN or STICKY`)
+}
+
+func TestSelector_Select(t *testing.T) {
+	t.Run("country prefers restricted senders", func(t *testing.T) {
+		selector := NewSelector(StrategyCountry)
+
+		selected, ok := selector.Select(pool, "+905551234567", "TR")
+		assert.True(t, ok)
+		assert.Equal(t, "+905550000000", selected.Value)
+
+		selected, ok = selector.Select(pool, "+4915112345678", "DE")
+		assert.True(t, ok)
+		assert.Equal(t, "Shop", selected.Value)
+	})
+
+	t.Run("round robin rotates through allowed senders", func(t *testing.T) {
+		selector := NewSelector(StrategyRoundRobin)
+
+		var values []string
+		for range 4 {
+			selected, ok := selector.Select(pool, "+905551234567", "TR")
+			assert.True(t, ok)
+			values = append(values, selected.Value)
+		}
+
+		assert.Equal(t, []string{"Shop", "+905550000000", "+905550000001", "Shop"}, values)
+	})
+
+	t.Run("sticky keeps the sender of a recipient", func(t *testing.T) {
+		selector := NewSelector(StrategySticky)
+
+		first, ok := selector.Select(pool, "+905551234567", "TR")
+		assert.True(t, ok)
+
+		for range 3 {
+			selected, _ := selector.Select(pool, "+905551234567", "TR")
+			assert.Equal(t, first, selected)
+		}
+
+		var others []Sender
+		for _, sender := range pool {
+			if sender.Value != first.Value && sender.Value != "+447400000000" {
+				others = append(others, sender)
+			}
+		}
+
+		reordered, ok := selector.Select(append(others, first), "+905551234567", "TR")
+		assert.True(t, ok)
+		assert.Equal(t, first, reordered)
+	})
+
+	t.Run("no allowed sender", func(t *testing.T) {
+		selector := NewSelector(StrategySticky)
+
+		_, ok := selector.Select(pool[1:], "+4915112345678", "DE")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,9 @@
+package sender
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, sender Sender) (*Sender, error)
+	List(ctx context.Context) ([]Sender, error)
+	Delete(ctx context.Context, id string) error
+}
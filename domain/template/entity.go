@@ -0,0 +1,225 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+
+	"messager/domain/fault"
+)
+
+const (
+	maxNameLength = 255
+)
+
+var (
+	ErrTemplateDoesNotValidForCreate = fault.New("TEMPLATE_INVALID_FOR_CREATE", "template does not valid for create")
+	ErrTemplateDoesNotValidForUpdate = fault.New("TEMPLATE_INVALID_FOR_UPDATE", "template does not valid for update")
+	ErrTemplateDoesNotValidForFind   = fault.New("TEMPLATE_INVALID_FOR_FIND", "template does not valid for find")
+	ErrTemplateNotFound              = fault.New("TEMPLATE_NOT_FOUND", "template not found")
+	ErrTemplateVariablesMissing      = fault.New("TEMPLATE_VARIABLES_MISSING", "template variables missing")
+)
+
+var placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+type Template struct {
+	ID            string
+	TenantID      string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Name          string
+	DefaultLocale string
+	Variants      []Variant
+}
+
+type Variant struct {
+	Locale  string
+	Content string
+}
+
+func (t *Template) NewErrTemplateDoesNotValidForCreate() error {
+	return ErrTemplateDoesNotValidForCreate
+}
+
+func (t *Template) NewErrTemplateDoesNotValidForUpdate() error {
+	return ErrTemplateDoesNotValidForUpdate
+}
+
+func (t *Template) NewErrTemplateDoesNotValidForFind() error {
+	return ErrTemplateDoesNotValidForFind
+}
+
+func (t *Template) NewErrTemplateNotFound() error {
+	return ErrTemplateNotFound
+}
+
+func (t *Template) ValidateForCreate() error {
+	if t.Name == "" {
+		return fault.NewFieldError("name", fault.CodeRequired, "template name must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(t.Name) > maxNameLength {
+		return fault.NewFieldError("name", fault.CodeTooLong,
+			fmt.Sprintf("template name must not exceed %d characters", maxNameLength),
+			map[string]any{"max": maxNameLength})
+	}
+
+	if strings.TrimSpace(t.Name) != t.Name {
+		return fault.NewFieldError("name", fault.CodeWhitespace, "template name must not contain leading or trailing whitespace", nil)
+	}
+
+	if len(t.Variants) == 0 {
+		return fault.NewFieldError("variants", fault.CodeRequired, "template must have at least one variant", nil)
+	}
+
+	// Locales are compared in the canonical form Normalize stores them in, so
+	// that "en-us" and "en-US" are the same locale.
+	locales := make([]string, 0, len(t.Variants))
+
+	for _, variant := range t.Variants {
+		tag, err := language.Parse(variant.Locale)
+		if err != nil {
+			return fault.NewFieldError("variants", fault.CodeInvalid,
+				fmt.Sprintf("template variant locale %q must be a valid BCP 47 language tag", variant.Locale),
+				map[string]any{"locale": variant.Locale})
+		}
+
+		if slices.Contains(locales, tag.String()) {
+			return fault.NewFieldError("variants", fault.CodeNotUnique,
+				fmt.Sprintf("template variant locale %q must be unique", variant.Locale),
+				map[string]any{"locale": variant.Locale})
+		}
+
+		if strings.TrimSpace(variant.Content) == "" {
+			return fault.NewFieldError("variants", fault.CodeRequired,
+				fmt.Sprintf("template variant %q content must be provided", variant.Locale),
+				map[string]any{"locale": variant.Locale})
+		}
+
+		locales = append(locales, tag.String())
+	}
+
+	if t.DefaultLocale != "" && !slices.Contains(locales, language.Make(t.DefaultLocale).String()) {
+		return fault.NewFieldError("defaultLocale", fault.CodeNotOneOf, "template default locale must be one of the variant locales",
+			map[string]any{"allowed": locales})
+	}
+
+	return nil
+}
+
+func (t *Template) ValidateForUpdate() error {
+	if err := t.ValidateForFind(); err != nil {
+		return err
+	}
+
+	return t.ValidateForCreate()
+}
+
+func (t *Template) ValidateForFind() error {
+	if t.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "template id must be provided", nil)
+	}
+
+	if err := uuid.Validate(t.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("template id must be a valid uuid: %v", err), nil)
+	}
+
+	return nil
+}
+
+func (t *Template) Normalize() {
+	for i := range t.Variants {
+		t.Variants[i].Locale = language.Make(t.Variants[i].Locale).String()
+	}
+
+	if t.DefaultLocale == "" {
+		t.DefaultLocale = t.Variants[0].Locale
+	}
+
+	t.DefaultLocale = language.Make(t.DefaultLocale).String()
+}
+
+// SelectVariant picks the variant for the requested locale. Without an exact
+// match, the variant sharing its base language is used. Without a locale, the
+// language most likely spoken in the region is used. The default variant is
+// the final fallback.
+func (t *Template) SelectVariant(locale, region string) Variant {
+	if locale == "" && region != "" {
+		if parsedRegion, err := language.ParseRegion(region); err == nil {
+			if tag, err := language.Compose(parsedRegion); err == nil {
+				locale = tag.String()
+			}
+		}
+	}
+
+	if locale != "" {
+		requested := language.Make(locale)
+
+		for _, variant := range t.Variants {
+			if language.Make(variant.Locale) == requested {
+				return variant
+			}
+		}
+
+		requestedBase, _ := requested.Base()
+
+		for _, variant := range t.Variants {
+			if base, _ := language.Make(variant.Locale).Base(); base == requestedBase {
+				return variant
+			}
+		}
+	}
+
+	for _, variant := range t.Variants {
+		if variant.Locale == t.DefaultLocale {
+			return variant
+		}
+	}
+
+	return t.Variants[0]
+}
+
+// Render replaces every {{name}} placeholder of the variant with its value.
+// Every placeholder must have a value.
+func (v *Variant) Render(variables map[string]string) (string, error) {
+	var missing []string
+
+	content := placeholder.ReplaceAllStringFunc(v.Content, func(match string) string {
+		name := placeholder.FindStringSubmatch(match)[1]
+
+		value, ok := variables[name]
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+
+			return match
+		}
+
+		return value
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrTemplateVariablesMissing, strings.Join(missing, ", "))
+	}
+
+	return content, nil
+}
+
+func (v *Variant) Variables() []string {
+	var names []string
+
+	for _, match := range placeholder.FindAllStringSubmatch(v.Content, -1) {
+		if !slices.Contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+
+	return names
+}
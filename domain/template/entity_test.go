@@ -0,0 +1,169 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+)
+
+func TestTemplate_ValidateForCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template Template
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name: "valid template",
+			template: Template{
+				Name:     "otp",
+				Variants: []Variant{{Locale: "en", Content: "Your code is {{code}}."}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty name",
+			template: Template{
+				Variants: []Variant{{Locale: "en", Content: "Your code is {{code}}."}},
+			},
+			wantErr: true,
+			errMsg:  "template name must be provided",
+		},
+		{
+			name: "no variants",
+			template: Template{
+				Name: "otp",
+			},
+			wantErr: true,
+			errMsg:  "template must have at least one variant",
+		},
+		{
+			name: "invalid locale",
+			template: Template{
+				Name:     "otp",
+				Variants: []Variant{{Locale: "not a locale", Content: "Your code is {{code}}."}},
+			},
+			wantErr: true,
+			errMsg:  `template variant locale "not a locale" must be a valid BCP 47 language tag`,
+		},
+		{
+			name: "duplicate locale",
+			template: Template{
+				Name: "otp",
+				Variants: []Variant{
+					{Locale: "en", Content: "Your code is {{code}}."},
+					{Locale: "en", Content: "Code: {{code}}."},
+				},
+			},
+			wantErr: true,
+			errMsg:  `template variant locale "en" must be unique`,
+		},
+		{
+			name: "duplicate locale in another case",
+			template: Template{
+				Name: "otp",
+				Variants: []Variant{
+					{Locale: "en-US", Content: "Your code is {{code}}."},
+					{Locale: "en-us", Content: "Code: {{code}}."},
+				},
+			},
+			wantErr: true,
+			errMsg:  `template variant locale "en-us" must be unique`,
+		},
+		{
+			name: "default locale in another case",
+			template: Template{
+				Name:          "otp",
+				DefaultLocale: "pt-br",
+				Variants:      []Variant{{Locale: "pt-BR", Content: "Seu código é {{code}}."}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty content",
+			template: Template{
+				Name:     "otp",
+				Variants: []Variant{{Locale: "en", Content: " "}},
+			},
+			wantErr: true,
+			errMsg:  `template variant "en" content must be provided`,
+		},
+		{
+			name: "unknown default locale",
+			template: Template{
+				Name:          "otp",
+				DefaultLocale: "tr",
+				Variants:      []Variant{{Locale: "en", Content: "Your code is {{code}}."}},
+			},
+			wantErr: true,
+			errMsg:  "template default locale must be one of the variant locales",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.ValidateForCreate()
+			if tt.wantErr {
+				var fieldErr *fault.FieldError
+
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTemplate_SelectVariant(t *testing.T) {
+	template := Template{
+		DefaultLocale: "en",
+		Variants: []Variant{
+			{Locale: "en", Content: "Hello"},
+			{Locale: "tr", Content: "Merhaba"},
+			{Locale: "pt-BR", Content: "Olá"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		locale string
+		region string
+		want   string
+	}{
+		{name: "exact locale", locale: "pt-BR", want: "pt-BR"},
+		{name: "base language", locale: "tr-TR", want: "tr"},
+		{name: "region language", region: "TR", want: "tr"},
+		{name: "locale wins over region", locale: "en", region: "TR", want: "en"},
+		{name: "default locale", locale: "de", want: "en"},
+		{name: "no locale or region", want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, template.SelectVariant(tt.locale, tt.region).Locale)
+		})
+	}
+}
+
+func TestVariant_Render(t *testing.T) {
+	variant := Variant{Locale: "en", Content: "Hi {{ name }}, your code is {{code}}."}
+
+	t.Run("all variables", func(t *testing.T) {
+		content, err := variant.Render(map[string]string{"name": "Ada", "code": "123456"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Hi Ada, your code is 123456.", content)
+	})
+
+	t.Run("missing variables", func(t *testing.T) {
+		_, err := variant.Render(map[string]string{"name": "Ada"})
+		assert.ErrorIs(t, err, ErrTemplateVariablesMissing)
+		assert.Equal(t, "template variables missing: code", err.Error())
+	})
+
+	t.Run("variables", func(t *testing.T) {
+		assert.Equal(t, []string{"name", "code"}, variant.Variables())
+	})
+}
@@ -0,0 +1,10 @@
+package template
+
+import "messager/domain/message"
+
+type Preview struct {
+	Locale   string
+	Content  string
+	Encoding message.Encoding
+	Segments int
+}
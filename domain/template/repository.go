@@ -0,0 +1,13 @@
+package template
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, template *Template) error
+	FindAll(ctx context.Context, tenantID string) ([]Template, error)
+	FindByID(ctx context.Context, tenantID, id string) (*Template, error)
+	Update(ctx context.Context, template *Template) error
+	Delete(ctx context.Context, tenantID, id string) error
+}
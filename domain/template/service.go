@@ -0,0 +1,12 @@
+package template
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, template Template) (*Template, error)
+	List(ctx context.Context) ([]Template, error)
+	Get(ctx context.Context, id string) (*Template, error)
+	Update(ctx context.Context, template Template) (*Template, error)
+	Delete(ctx context.Context, id string) error
+	Preview(ctx context.Context, id, locale, phone string, variables map[string]string) (*Preview, error)
+}
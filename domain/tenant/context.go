@@ -0,0 +1,19 @@
+package tenant
+
+import "context"
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the tenant of a request.
+func NewContext(ctx context.Context, tenant Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenant)
+}
+
+// FromContext returns the tenant of ctx and reports whether ctx carries one.
+// Background jobs act for the default tenant and set it explicitly with
+// NewContext and Default.
+func FromContext(ctx context.Context) (Tenant, bool) {
+	tenant, ok := ctx.Value(contextKey{}).(Tenant)
+
+	return tenant, ok
+}
@@ -0,0 +1,156 @@
+package tenant
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/nyaruka/phonenumbers"
+
+	"messager/domain/fault"
+)
+
+const (
+	// DefaultID is the tenant of requests that do not name one and of the rows
+	// created before tenants were introduced. It is the operator of messager.
+	DefaultID   = "00000000-0000-0000-0000-000000000000"
+	DefaultName = "default"
+
+	maxNameLength = 100
+)
+
+var (
+	ErrTenantDoesNotValidForCreate = fault.New("TENANT_INVALID_FOR_CREATE", "tenant does not valid for create")
+	ErrTenantDoesNotValidForFind   = fault.New("TENANT_INVALID_FOR_FIND", "tenant does not valid for find")
+	ErrTenantDoesNotValidForUpdate = fault.New("TENANT_INVALID_FOR_UPDATE", "tenant does not valid for update")
+	ErrTenantNotFound              = fault.New("TENANT_NOT_FOUND", "tenant not found")
+	ErrTenantForbidden             = fault.New("TENANT_FORBIDDEN", "tenant is not allowed to access this resource")
+	ErrTenantQuotaExceeded         = fault.New("TENANT_QUOTA_EXCEEDED", "tenant daily quota exceeded")
+	ErrTenantMissing               = fault.New("TENANT_MISSING", "tenant is missing from the context")
+)
+
+// Tenant is a product messager sends messages for. Messages, templates,
+// campaigns, senders, content policies and consents belong to a tenant and
+// are only visible to it.
+type Tenant struct {
+	ID            string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Name          string
+	DefaultRegion string
+	DailyQuota    int
+	Paused        bool
+}
+
+// Default returns the default tenant, which background jobs act for.
+func Default() Tenant {
+	return Tenant{
+		ID:   DefaultID,
+		Name: DefaultName,
+	}
+}
+
+func (t *Tenant) NewErrTenantDoesNotValidForCreate() error {
+	return ErrTenantDoesNotValidForCreate
+}
+
+func (t *Tenant) NewErrTenantDoesNotValidForFind() error {
+	return ErrTenantDoesNotValidForFind
+}
+
+func (t *Tenant) NewErrTenantDoesNotValidForUpdate() error {
+	return ErrTenantDoesNotValidForUpdate
+}
+
+func (t *Tenant) NewErrTenantNotFound() error {
+	return ErrTenantNotFound
+}
+
+func (t *Tenant) NewErrTenantForbidden() error {
+	return ErrTenantForbidden
+}
+
+func (t *Tenant) NewErrTenantQuotaExceeded() error {
+	return ErrTenantQuotaExceeded
+}
+
+func (t *Tenant) NewErrTenantMissing() error {
+	return ErrTenantMissing
+}
+
+func (t *Tenant) ValidateForCreate() error {
+	if t.Name == "" {
+		return fault.NewFieldError("name", fault.CodeRequired, "tenant name must be provided", nil)
+	}
+
+	if utf8.RuneCountInString(t.Name) > maxNameLength {
+		return fault.NewFieldError("name", fault.CodeTooLong,
+			fmt.Sprintf("tenant name must not exceed %d characters", maxNameLength),
+			map[string]any{"max": maxNameLength})
+	}
+
+	if strings.TrimSpace(t.Name) != t.Name {
+		return fault.NewFieldError("name", fault.CodeWhitespace, "tenant name must not contain leading or trailing whitespace", nil)
+	}
+
+	if t.DefaultRegion != "" && phonenumbers.GetCountryCodeForRegion(strings.ToUpper(t.DefaultRegion)) == 0 {
+		return fault.NewFieldError("defaultRegion", fault.CodeInvalid,
+			fmt.Sprintf("tenant default region %q must be a valid ISO 3166-1 alpha-2 region", t.DefaultRegion), nil)
+	}
+
+	if t.DailyQuota < 0 {
+		return fault.NewFieldError("dailyQuota", fault.CodeInvalid, "tenant daily quota must not be negative",
+			map[string]any{"min": 0})
+	}
+
+	return nil
+}
+
+func (t *Tenant) ValidateForFind() error {
+	if t.ID == "" {
+		return fault.NewFieldError("id", fault.CodeRequired, "tenant id must be provided", nil)
+	}
+
+	if err := uuid.Validate(t.ID); err != nil {
+		return fault.NewFieldError("id", fault.CodeInvalid, fmt.Sprintf("tenant id must be a valid uuid: %v", err), nil)
+	}
+
+	return nil
+}
+
+func (t *Tenant) ValidateForUpdate() error {
+	if err := t.ValidateForFind(); err != nil {
+		return err
+	}
+
+	return t.ValidateForCreate()
+}
+
+// NormalizeForCreate formats the default region in upper case, so that it
+// matches the regions phone numbers are parsed in.
+func (t *Tenant) NormalizeForCreate() {
+	t.DefaultRegion = strings.ToUpper(t.DefaultRegion)
+}
+
+// Region returns the region phone numbers of the tenant are parsed in, which
+// is fallback unless the tenant has a default region of its own.
+func (t *Tenant) Region(fallback string) string {
+	if t.DefaultRegion == "" {
+		return fallback
+	}
+
+	return t.DefaultRegion
+}
+
+// IsDefault reports whether the tenant is the operator of messager, which is
+// the only one allowed to manage tenants.
+func (t *Tenant) IsDefault() bool {
+	return t.ID == DefaultID
+}
+
+// HasQuota reports whether the tenant's messages are limited per day.
+func (t *Tenant) HasQuota() bool {
+	return t.DailyQuota > 0
+}
@@ -0,0 +1,114 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+)
+
+func TestTenant_ValidateForCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tenant  Tenant
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid",
+			tenant:  Tenant{Name: "Shop", DefaultRegion: "tr", DailyQuota: 1000},
+			wantErr: false,
+		},
+		{
+			name:    "without region and quota",
+			tenant:  Tenant{Name: "Shop"},
+			wantErr: false,
+		},
+		{
+			name:    "empty name",
+			tenant:  Tenant{},
+			wantErr: true,
+			errMsg:  "tenant name must be provided",
+		},
+		{
+			name:    "name too long",
+			tenant:  Tenant{Name: strings.Repeat("a", maxNameLength+1)},
+			wantErr: true,
+			errMsg:  "tenant name must not exceed 100 characters",
+		},
+		{
+			name:    "name with whitespace",
+			tenant:  Tenant{Name: " Shop"},
+			wantErr: true,
+			errMsg:  "tenant name must not contain leading or trailing whitespace",
+		},
+		{
+			name:    "invalid region",
+			tenant:  Tenant{Name: "Shop", DefaultRegion: "XX"},
+			wantErr: true,
+			errMsg:  `tenant default region "XX" must be a valid ISO 3166-1 alpha-2 region`,
+		},
+		{
+			name:    "negative quota",
+			tenant:  Tenant{Name: "Shop", DailyQuota: -1},
+			wantErr: true,
+			errMsg:  "tenant daily quota must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tenant.ValidateForCreate()
+			if tt.wantErr {
+				var fieldErr *fault.FieldError
+
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTenant_ValidateForUpdate(t *testing.T) {
+	tenant := Tenant{Name: "Shop"}
+	assert.EqualError(t, tenant.ValidateForUpdate(), "tenant id must be provided")
+
+	tenant.ID = "invalid"
+	assert.Error(t, tenant.ValidateForUpdate())
+
+	tenant.ID = DefaultID
+	assert.NoError(t, tenant.ValidateForUpdate())
+}
+
+func TestTenant_Region(t *testing.T) {
+	tenant := Tenant{Name: "Shop", DefaultRegion: "gb"}
+	assert.Equal(t, "gb", tenant.Region("TR"))
+
+	tenant.NormalizeForCreate()
+	assert.Equal(t, "GB", tenant.Region("TR"))
+
+	tenant.DefaultRegion = ""
+	assert.Equal(t, "TR", tenant.Region("TR"))
+}
+
+func TestFromContext(t *testing.T) {
+	current, ok := FromContext(context.Background())
+	assert.False(t, ok)
+	assert.False(t, current.IsDefault())
+
+	current, ok = FromContext(NewContext(context.Background(), Default()))
+	assert.True(t, ok)
+	assert.True(t, current.IsDefault())
+	assert.False(t, current.HasQuota())
+
+	ctx := NewContext(context.Background(), Tenant{ID: "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d", DailyQuota: 10})
+	current, ok = FromContext(ctx)
+	assert.True(t, ok)
+	assert.False(t, current.IsDefault())
+	assert.True(t, current.HasQuota())
+}
@@ -0,0 +1,13 @@
+package tenant
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, tenant *Tenant) error
+	FindAll(ctx context.Context) ([]Tenant, error)
+	FindByID(ctx context.Context, id string) (*Tenant, error)
+	Update(ctx context.Context, tenant *Tenant) error
+	UpdatePaused(ctx context.Context, id string, paused bool) error
+}
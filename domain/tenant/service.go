@@ -0,0 +1,13 @@
+package tenant
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, tenant Tenant) (*Tenant, error)
+	List(ctx context.Context) ([]Tenant, error)
+	Get(ctx context.Context, id string) (*Tenant, error)
+	Update(ctx context.Context, tenant Tenant) (*Tenant, error)
+	Resolve(ctx context.Context, id string) (*Tenant, error)
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+}
@@ -5,15 +5,27 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"messager/domain/message"
 )
 
+var ErrTemporary = errors.New("temporary failure")
+
 type Client interface {
-	SendMessage(ctx context.Context, message message.Message) (id string, err error)
+	Provider() string
+	SendMessage(ctx context.Context, message message.Message) (Response, error)
+}
+
+// Response is the reply of a provider to a message. Status is the status code
+// it replied with, also on failure, and 0 when it did not reply.
+type Response struct {
+	ID     string
+	Status int
 }
 
 type Config struct {
@@ -23,11 +35,13 @@ type Config struct {
 }
 
 type client struct {
-	client *http.Client
-	config *Config
+	client   *http.Client
+	config   *Config
+	provider string
 }
 
 type requestPayload struct {
+	From    string `json:"from,omitempty"`
 	To      string `json:"to"`
 	Content string `json:"content"`
 }
@@ -37,6 +51,11 @@ type responsePayload struct {
 }
 
 func New(config Config) Client {
+	provider := config.URL
+	if parsed, err := url.Parse(config.URL); err == nil && parsed.Host != "" {
+		provider = parsed.Host
+	}
+
 	return &client{
 		client: &http.Client{
 			Transport: &http.Transport{
@@ -46,22 +65,29 @@ func New(config Config) Client {
 			},
 			Timeout: config.Timeout,
 		},
-		config: &config,
+		config:   &config,
+		provider: provider,
 	}
 }
 
-func (c *client) SendMessage(ctx context.Context, message message.Message) (id string, err error) {
+// Provider returns the host messages are posted to.
+func (c *client) Provider() string {
+	return c.provider
+}
+
+func (c *client) SendMessage(ctx context.Context, message message.Message) (Response, error) {
 	body, err := json.Marshal(requestPayload{
+		From:    message.Sender,
 		To:      message.Phone,
 		Content: message.Content,
 	})
 	if err != nil {
-		return "", fmt.Errorf("json.Marshal(): %w", err)
+		return Response{}, fmt.Errorf("json.Marshal(): %w", err)
 	}
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return "", fmt.Errorf("http.NewRequestWithContext(): %w", err)
+		return Response{}, fmt.Errorf("http.NewRequestWithContext(): %w", err)
 	}
 
 	request.Header.Set("Content-Type", "application/json")
@@ -69,20 +95,30 @@ func (c *client) SendMessage(ctx context.Context, message message.Message) (id s
 
 	response, err := c.client.Do(request)
 	if err != nil {
-		return "", fmt.Errorf("http.Client.Do(): %w", err)
+		return Response{}, fmt.Errorf("http.Client.Do(): %w: %w", ErrTemporary, err)
 	}
 
 	defer response.Body.Close()
 
+	result := Response{
+		Status: response.StatusCode,
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError {
+		return result, fmt.Errorf("http.Client.Do(): %w: unexpected response status code %d", ErrTemporary, response.StatusCode)
+	}
+
 	if response.StatusCode != http.StatusAccepted {
-		return "", fmt.Errorf("http.Client.Do(): unexpected response status code %d", response.StatusCode)
+		return result, fmt.Errorf("http.Client.Do(): unexpected response status code %d", response.StatusCode)
 	}
 
 	var payload responsePayload
 
 	if err := json.NewDecoder(response.Body).Decode(&payload); err != nil {
-		return "", fmt.Errorf("json.NewDecoder().Decode(): %w", err)
+		return result, fmt.Errorf("json.NewDecoder().Decode(): %w", err)
 	}
 
-	return payload.MessageID, nil
+	result.ID = payload.MessageID
+
+	return result, nil
 }
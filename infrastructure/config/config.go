@@ -8,12 +8,23 @@ import (
 )
 
 type Config interface {
+	GetMessager() Messager
 	GetServer() Server
 	GetPostgreSQL() PostgreSQL
 	GetRedis() Redis
 	GetJob() Job
 	GetKafka() Kafka
+	GetOutbox() Outbox
 	GetClient() Client
+	GetSMTP() SMTP
+	GetMessage() Message
+	GetCampaign() Campaign
+	GetContentPolicy() ContentPolicy
+	GetSender() Sender
+}
+
+type Messager struct {
+	Mode string `env:"MODE"`
 }
 
 type Server struct {
@@ -23,29 +34,41 @@ type Server struct {
 }
 
 type PostgreSQL struct {
-	Host     string `env:"HOST,required,notEmpty"`
-	Port     uint16 `env:"PORT,required,notEmpty"`
-	User     string `env:"USER,required,notEmpty"`
-	Password string `env:"PASSWORD,required,notEmpty"`
-	Name     string `env:"NAME,required,notEmpty"`
+	Host          string `env:"HOST"`
+	Port          uint16 `env:"PORT"`
+	User          string `env:"USER"`
+	Password      string `env:"PASSWORD"`
+	Name          string `env:"NAME"`
+	MigrationMode string `env:"MIGRATION_MODE"`
 }
 
 type Redis struct {
-	Host     string `env:"HOST,required,notEmpty"`
-	Port     uint16 `env:"PORT,required,notEmpty"`
+	Host     string `env:"HOST"`
+	Port     uint16 `env:"PORT"`
 	User     string `env:"USER"`
 	Password string `env:"PASSWORD"`
-	DB       uint16 `env:"DB,required,notEmpty"`
+	DB       uint16 `env:"DB"`
 }
 
 type Job struct {
-	Interval time.Duration `env:"INTERVAL,required,notEmpty"`
+	Interval               time.Duration `env:"INTERVAL,required,notEmpty"`
+	TransactionalBatchSize int           `env:"TRANSACTIONAL_BATCH_SIZE,required,notEmpty"`
+	NormalBatchSize        int           `env:"NORMAL_BATCH_SIZE,required,notEmpty"`
+	BulkBatchSize          int           `env:"BULK_BATCH_SIZE,required,notEmpty"`
+	ExpirationInterval     time.Duration `env:"EXPIRATION_INTERVAL,required,notEmpty"`
+	ClaimTimeout           time.Duration `env:"CLAIM_TIMEOUT,required,notEmpty"`
 }
 
 type Kafka struct {
-	Brokers []string `env:"BROKERS,required,notEmpty"`
-	Topic   string   `env:"TOPIC,required,notEmpty"`
-	GroupID string   `env:"GROUP_ID,required,notEmpty"`
+	Brokers []string `env:"BROKERS"`
+	Topic   string   `env:"TOPIC"`
+	GroupID string   `env:"GROUP_ID"`
+}
+
+type Outbox struct {
+	Relay     string        `env:"RELAY"`
+	Interval  time.Duration `env:"INTERVAL,required,notEmpty"`
+	BatchSize int           `env:"BATCH_SIZE,required,notEmpty"`
 }
 
 type Client struct {
@@ -54,13 +77,58 @@ type Client struct {
 	Timeout time.Duration `env:"TIMEOUT,required,notEmpty"`
 }
 
+type SMTP struct {
+	Host          string        `env:"HOST"`
+	Port          uint16        `env:"PORT"`
+	Username      string        `env:"USERNAME"`
+	Password      string        `env:"PASSWORD"`
+	From          string        `env:"FROM"`
+	RequireTLS    bool          `env:"REQUIRE_TLS"`
+	TLSSkipVerify bool          `env:"TLS_SKIP_VERIFY"`
+	Timeout       time.Duration `env:"TIMEOUT"`
+}
+
+type Message struct {
+	MaxSegments             int           `env:"MAX_SEGMENTS,required,notEmpty"`
+	DefaultRegion           string        `env:"DEFAULT_REGION,required,notEmpty"`
+	IdempotencyTTL          time.Duration `env:"IDEMPOTENCY_TTL,required,notEmpty"`
+	MaxBatchSize            int           `env:"MAX_BATCH_SIZE,required,notEmpty"`
+	QuietHours              string        `env:"QUIET_HOURS"`
+	TransactionalQuietHours string        `env:"TRANSACTIONAL_QUIET_HOURS"`
+	MarketingQuietHours     string        `env:"MARKETING_QUIET_HOURS"`
+	AttemptCacheTTL         time.Duration `env:"ATTEMPT_CACHE_TTL"`
+}
+
+type Campaign struct {
+	MaxRecipients int `env:"MAX_RECIPIENTS,required,notEmpty"`
+}
+
+type ContentPolicy struct {
+	ForbiddenWords   []string `env:"FORBIDDEN_WORDS"`
+	ForbiddenPattern string   `env:"FORBIDDEN_PATTERN"`
+	AllowedDomains   []string `env:"ALLOWED_DOMAINS"`
+	RequiredFooter   string   `env:"REQUIRED_FOOTER"`
+	MaxLinks         int      `env:"MAX_LINKS"`
+}
+
+type Sender struct {
+	Strategy string `env:"STRATEGY"`
+}
+
 type config struct {
-	Server     Server     `envPrefix:"SERVER_"`
-	PostgreSQL PostgreSQL `envPrefix:"POSTGRESQL_"`
-	Redis      Redis      `envPrefix:"REDIS_"`
-	Job        Job        `envPrefix:"JOB_"`
-	Kafka      Kafka      `envPrefix:"KAFKA_"`
-	Client     Client     `envPrefix:"CLIENT_"`
+	Messager      Messager      `envPrefix:"MESSAGER_"`
+	Server        Server        `envPrefix:"SERVER_"`
+	PostgreSQL    PostgreSQL    `envPrefix:"POSTGRESQL_"`
+	Redis         Redis         `envPrefix:"REDIS_"`
+	Job           Job           `envPrefix:"JOB_"`
+	Kafka         Kafka         `envPrefix:"KAFKA_"`
+	Outbox        Outbox        `envPrefix:"OUTBOX_"`
+	Client        Client        `envPrefix:"CLIENT_"`
+	SMTP          SMTP          `envPrefix:"SMTP_"`
+	Message       Message       `envPrefix:"MESSAGE_"`
+	Campaign      Campaign      `envPrefix:"CAMPAIGN_"`
+	ContentPolicy ContentPolicy `envPrefix:"CONTENT_POLICY_"`
+	Sender        Sender        `envPrefix:"SENDER_"`
 }
 
 func New() (Config, error) {
@@ -73,6 +141,10 @@ func New() (Config, error) {
 	return &cfg, nil
 }
 
+func (c *config) GetMessager() Messager {
+	return c.Messager
+}
+
 func (c *config) GetServer() Server {
 	return c.Server
 }
@@ -93,6 +165,30 @@ func (c *config) GetKafka() Kafka {
 	return c.Kafka
 }
 
+func (c *config) GetOutbox() Outbox {
+	return c.Outbox
+}
+
 func (c *config) GetClient() Client {
 	return c.Client
 }
+
+func (c *config) GetSMTP() SMTP {
+	return c.SMTP
+}
+
+func (c *config) GetMessage() Message {
+	return c.Message
+}
+
+func (c *config) GetCampaign() Campaign {
+	return c.Campaign
+}
+
+func (c *config) GetContentPolicy() ContentPolicy {
+	return c.ContentPolicy
+}
+
+func (c *config) GetSender() Sender {
+	return c.Sender
+}
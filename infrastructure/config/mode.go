@@ -0,0 +1,27 @@
+package config
+
+import "fmt"
+
+const (
+	// ModePostgres keeps the data in PostgreSQL and Redis.
+	ModePostgres Mode = "postgres"
+	// ModeEmbedded keeps the data in the memory of the process, so that
+	// messager runs as a single binary for local development. The data is
+	// lost when it stops.
+	ModeEmbedded Mode = "embedded"
+)
+
+// Mode is where messager keeps its data.
+type Mode string
+
+// ParseMode returns the mode named value, ModePostgres when value is empty.
+func ParseMode(value string) (Mode, error) {
+	switch Mode(value) {
+	case "", ModePostgres:
+		return ModePostgres, nil
+	case ModeEmbedded:
+		return ModeEmbedded, nil
+	default:
+		return "", fmt.Errorf("messager mode %q must be one of postgres or embedded", value)
+	}
+}
@@ -12,6 +12,7 @@ type PostgreSQL interface {
 	Query(ctx context.Context, query string, arguments ...any) (Rows, error)
 	QueryRow(ctx context.Context, query string, arguments ...any) Row
 	Exec(ctx context.Context, query string, arguments ...any) error
+	Transaction(ctx context.Context, fn func(tx Tx) error) error
 }
 
 type Config struct {
@@ -35,6 +36,10 @@ func New(config Config) (PostgreSQL, error) {
 		return nil, fmt.Errorf("pgxpool.ParseConfig(): %w", err)
 	}
 
+	// Timestamps are stored in UTC without a time zone, so now() and the
+	// column defaults must be in UTC whatever the time zone of the server is.
+	poolConfig.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
 	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("pgxpool.NewWithConfig(): %w", err)
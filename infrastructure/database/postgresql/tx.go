@@ -0,0 +1,68 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Tx runs statements in a transaction on a single connection.
+type Tx interface {
+	Query(ctx context.Context, query string, arguments ...any) (Rows, error)
+	QueryRow(ctx context.Context, query string, arguments ...any) Row
+	Exec(ctx context.Context, query string, arguments ...any) error
+}
+
+type tx struct {
+	tx pgx.Tx
+}
+
+// Transaction runs fn in a transaction, which is committed when fn succeeds
+// and rolled back otherwise.
+func (p *postgreSQL) Transaction(ctx context.Context, fn func(tx Tx) error) error {
+	pgxTx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgreSQL.pool.Begin(): %w", err)
+	}
+
+	if err := fn(&tx{tx: pgxTx}); err != nil {
+		if rollbackErr := pgxTx.Rollback(ctx); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("postgreSQL.tx.Rollback(): %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	if err := pgxTx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgreSQL.tx.Commit(): %w", err)
+	}
+
+	return nil
+}
+
+func (t *tx) Query(ctx context.Context, query string, arguments ...any) (Rows, error) {
+	queryRows, err := t.tx.Query(ctx, query, arguments...)
+	if err != nil {
+		return nil, fmt.Errorf("tx.tx.Query(): %w", err)
+	}
+
+	return &rows{
+		rows: queryRows,
+	}, nil
+}
+
+func (t *tx) QueryRow(ctx context.Context, query string, arguments ...any) Row {
+	return &row{
+		row: t.tx.QueryRow(ctx, query, arguments...),
+	}
+}
+
+func (t *tx) Exec(ctx context.Context, query string, arguments ...any) error {
+	if _, err := t.tx.Exec(ctx, query, arguments...); err != nil {
+		return fmt.Errorf("tx.tx.Exec(): %w", err)
+	}
+
+	return nil
+}
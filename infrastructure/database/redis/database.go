@@ -2,15 +2,24 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	rdb "github.com/redis/go-redis/v9"
 )
 
+var ErrNil = errors.New("redis: nil")
+
 type Redis interface {
 	Close() error
-	Set(ctx context.Context, key string, value any) error
+	Set(ctx context.Context, key string, value any, ttl time.Duration) error
+	SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
+	Get(ctx context.Context, key string) (string, error)
+	Del(ctx context.Context, key string) error
+	IncrBy(ctx context.Context, key string, value int64) (int64, error)
+	Expire(ctx context.Context, key string, ttl time.Duration) error
 }
 
 type Config struct {
@@ -51,10 +60,56 @@ func (r *redis) Close() error {
 	return nil
 }
 
-func (r *redis) Set(ctx context.Context, key string, value any) error {
-	if err := r.client.Set(ctx, key, value, 0).Err(); err != nil {
+func (r *redis) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	if err := r.client.Set(ctx, key, value, ttl).Err(); err != nil {
 		return fmt.Errorf("redis.client.Set(): %w", err)
 	}
 
 	return nil
 }
+
+func (r *redis) SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	set, err := r.client.SetNX(ctx, key, value, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis.client.SetNX(): %w", err)
+	}
+
+	return set, nil
+}
+
+func (r *redis) Get(ctx context.Context, key string) (string, error) {
+	value, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, rdb.Nil) {
+		return "", fmt.Errorf("redis.client.Get(): %w", ErrNil)
+	}
+	if err != nil {
+		return "", fmt.Errorf("redis.client.Get(): %w", err)
+	}
+
+	return value, nil
+}
+
+func (r *redis) Del(ctx context.Context, key string) error {
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("redis.client.Del(): %w", err)
+	}
+
+	return nil
+}
+
+func (r *redis) IncrBy(ctx context.Context, key string, value int64) (int64, error) {
+	result, err := r.client.IncrBy(ctx, key, value).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis.client.IncrBy(): %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *redis) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	if err := r.client.Expire(ctx, key, ttl).Err(); err != nil {
+		return fmt.Errorf("redis.client.Expire(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	value     string
+	expiresAt time.Time
+}
+
+type memory struct {
+	mu      sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+// NewMemory returns a Redis kept in the memory of the process, for running
+// messager without a Redis server. Values are stored as strings the way Redis
+// stores them, and expired keys are dropped when they are read.
+func NewMemory() Redis {
+	return &memory{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+func (m *memory) Close() error {
+	return nil
+}
+
+func (m *memory) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = m.newEntry(format(value), ttl)
+
+	return nil
+}
+
+func (m *memory) SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.get(key); ok {
+		return false, nil
+	}
+
+	m.entries[key] = m.newEntry(format(value), ttl)
+
+	return true, nil
+}
+
+func (m *memory) Get(ctx context.Context, key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.get(key)
+	if !ok {
+		return "", fmt.Errorf("redis.memory.Get(): %w", ErrNil)
+	}
+
+	return stored.value, nil
+}
+
+func (m *memory) Del(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+
+	return nil
+}
+
+// IncrBy adds value to the integer stored at key, which counts from zero when
+// it is missing. The expiration of the key is kept.
+func (m *memory) IncrBy(ctx context.Context, key string, value int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.get(key)
+	if !ok {
+		stored = entry{value: "0"}
+	}
+
+	current, err := strconv.ParseInt(stored.value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("strconv.ParseInt(): %w", err)
+	}
+
+	stored.value = strconv.FormatInt(current+value, 10)
+	m.entries[key] = stored
+
+	return current + value, nil
+}
+
+func (m *memory) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.get(key)
+	if !ok {
+		return nil
+	}
+
+	m.entries[key] = m.newEntry(stored.value, ttl)
+
+	return nil
+}
+
+// get returns the entry stored at key unless it expired, in which case it is
+// dropped.
+func (m *memory) get(key string) (entry, bool) {
+	stored, ok := m.entries[key]
+	if !ok {
+		return entry{}, false
+	}
+
+	if !stored.expiresAt.IsZero() && !m.now().Before(stored.expiresAt) {
+		delete(m.entries, key)
+
+		return entry{}, false
+	}
+
+	return stored, true
+}
+
+// newEntry returns an entry expiring after ttl, a ttl of zero never expires.
+func (m *memory) newEntry(value string, ttl time.Duration) entry {
+	stored := entry{
+		value: value,
+	}
+
+	if ttl > 0 {
+		stored.expiresAt = m.now().Add(ttl)
+	}
+
+	return stored
+}
+
+// format encodes a value the way the Redis client writes it.
+func format(value any) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	newMemory := func() *memory {
+		m := NewMemory().(*memory)
+		m.now = func() time.Time { return now }
+
+		return m
+	}
+
+	t.Run("get missing key", func(t *testing.T) {
+		m := newMemory()
+
+		_, err := m.Get(ctx, "key")
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	t.Run("set and get", func(t *testing.T) {
+		m := newMemory()
+
+		assert.NoError(t, m.Set(ctx, "key", []byte("value"), 0))
+
+		value, err := m.Get(ctx, "key")
+		assert.NoError(t, err)
+		assert.Equal(t, "value", value)
+	})
+
+	t.Run("set nx only sets missing keys", func(t *testing.T) {
+		m := newMemory()
+
+		set, err := m.SetNX(ctx, "key", "first", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, set)
+
+		set, err = m.SetNX(ctx, "key", "second", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, set)
+
+		value, err := m.Get(ctx, "key")
+		assert.NoError(t, err)
+		assert.Equal(t, "first", value)
+	})
+
+	t.Run("keys expire", func(t *testing.T) {
+		m := newMemory()
+
+		assert.NoError(t, m.Set(ctx, "key", "value", time.Minute))
+
+		m.now = func() time.Time { return now.Add(time.Minute) }
+
+		_, err := m.Get(ctx, "key")
+		assert.ErrorIs(t, err, ErrNil)
+
+		set, err := m.SetNX(ctx, "key", "value", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, set)
+	})
+
+	t.Run("incr by keeps the expiration", func(t *testing.T) {
+		m := newMemory()
+
+		total, err := m.IncrBy(ctx, "counter", 3)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+
+		assert.NoError(t, m.Expire(ctx, "counter", time.Hour))
+
+		total, err = m.IncrBy(ctx, "counter", -1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+
+		m.now = func() time.Time { return now.Add(time.Hour) }
+
+		total, err = m.IncrBy(ctx, "counter", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("incr by a value that is not an integer", func(t *testing.T) {
+		m := newMemory()
+
+		assert.NoError(t, m.Set(ctx, "key", "value", 0))
+
+		_, err := m.IncrBy(ctx, "key", 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("del", func(t *testing.T) {
+		m := newMemory()
+
+		assert.NoError(t, m.Set(ctx, "key", 42, 0))
+		assert.NoError(t, m.Del(ctx, "key"))
+
+		_, err := m.Get(ctx, "key")
+		assert.ErrorIs(t, err, ErrNil)
+	})
+}
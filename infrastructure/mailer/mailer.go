@@ -0,0 +1,275 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"messager/domain/message"
+	"messager/infrastructure/client"
+)
+
+type Config struct {
+	Host          string
+	Port          uint16
+	Username      string
+	Password      string
+	From          string
+	RequireTLS    bool
+	TLSSkipVerify bool
+	Timeout       time.Duration
+}
+
+type mailer struct {
+	config *Config
+}
+
+// New returns a client delivering email messages over SMTP. The connection is
+// upgraded with STARTTLS whenever the server offers it.
+func New(config Config) client.Client {
+	return &mailer{
+		config: &config,
+	}
+}
+
+// replyOK is the reply of an SMTP server accepting a message.
+const replyOK = 250
+
+// Provider returns the host of the SMTP server.
+func (m *mailer) Provider() string {
+	return m.config.Host
+}
+
+// SendMessage sends the message, replying with the code of the SMTP reply
+// that rejected it on failure.
+func (m *mailer) SendMessage(ctx context.Context, message message.Message) (client.Response, error) {
+	id, err := m.send(ctx, message)
+	if err != nil {
+		var protocolErr *textproto.Error
+		if errors.As(err, &protocolErr) {
+			return client.Response{Status: protocolErr.Code}, err
+		}
+
+		return client.Response{}, err
+	}
+
+	return client.Response{ID: id, Status: replyOK}, nil
+}
+
+func (m *mailer) send(ctx context.Context, message message.Message) (id string, err error) {
+	from, err := mail.ParseAddress(m.config.From)
+	if err != nil {
+		return "", fmt.Errorf("mail.ParseAddress(): %w", err)
+	}
+
+	id = fmt.Sprintf("%s@%s", uuid.New().String(), domainOf(from.Address))
+
+	body, err := compose(from, message, id)
+	if err != nil {
+		return "", err
+	}
+
+	if m.config.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, m.config.Timeout)
+		defer cancel()
+	}
+
+	address := net.JoinHostPort(m.config.Host, strconv.Itoa(int(m.config.Port)))
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", fmt.Errorf("net.Dialer.DialContext(): %w: %w", client.ErrTemporary, err)
+	}
+
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return "", fmt.Errorf("net.Conn.SetDeadline(): %w", err)
+		}
+	}
+
+	smtpClient, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		return "", wrap("smtp.NewClient()", err)
+	}
+
+	defer smtpClient.Close()
+
+	if ok, _ := smtpClient.Extension("STARTTLS"); ok {
+		if err := smtpClient.StartTLS(&tls.Config{
+			ServerName:         m.config.Host,
+			InsecureSkipVerify: m.config.TLSSkipVerify,
+		}); err != nil {
+			return "", wrap("smtp.Client.StartTLS()", err)
+		}
+	} else if m.config.RequireTLS {
+		return "", fmt.Errorf("smtp.Client.Extension(): server %s does not support STARTTLS", address)
+	}
+
+	if m.config.Username != "" {
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+
+		if err := smtpClient.Auth(auth); err != nil {
+			return "", wrap("smtp.Client.Auth()", err)
+		}
+	}
+
+	if err := smtpClient.Mail(from.Address); err != nil {
+		return "", wrap("smtp.Client.Mail()", err)
+	}
+
+	if err := smtpClient.Rcpt(message.Email); err != nil {
+		return "", wrap("smtp.Client.Rcpt()", err)
+	}
+
+	writer, err := smtpClient.Data()
+	if err != nil {
+		return "", wrap("smtp.Client.Data()", err)
+	}
+
+	if _, err := writer.Write(body); err != nil {
+		return "", wrap("io.Writer.Write()", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", wrap("io.WriteCloser.Close()", err)
+	}
+
+	if err := smtpClient.Quit(); err != nil {
+		return "", wrap("smtp.Client.Quit()", err)
+	}
+
+	return id, nil
+}
+
+// compose builds the RFC 5322 representation of the message. Messages with
+// HTML content are sent as multipart/alternative with a plain text part.
+func compose(from *mail.Address, message message.Message, id string) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	header := [][2]string{
+		{"From", from.String()},
+		{"To", message.Email},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", "<" + id + ">"},
+		{"MIME-Version", "1.0"},
+	}
+
+	if message.HTMLContent == "" {
+		writeHeader(&buffer, append(header,
+			[2]string{"Content-Type", "text/plain; charset=utf-8"},
+			[2]string{"Content-Transfer-Encoding", "quoted-printable"},
+		))
+
+		if err := writeQuotedPrintable(&buffer, message.Content); err != nil {
+			return nil, err
+		}
+
+		return buffer.Bytes(), nil
+	}
+
+	writer := multipart.NewWriter(&buffer)
+
+	writeHeader(&buffer, append(header,
+		[2]string{"Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": writer.Boundary()})},
+	))
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", message.Content},
+		{"text/html; charset=utf-8", message.HTMLContent},
+	}
+
+	for _, part := range parts {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("multipart.Writer.CreatePart(): %w", err)
+		}
+
+		if err := writeQuotedPrintable(partWriter, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("multipart.Writer.Close(): %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeHeader(buffer *bytes.Buffer, header [][2]string) {
+	for _, field := range header {
+		fmt.Fprintf(buffer, "%s: %s\r\n", field[0], field[1])
+	}
+
+	buffer.WriteString("\r\n")
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	writer := quotedprintable.NewWriter(w)
+
+	if _, err := writer.Write([]byte(content)); err != nil {
+		return fmt.Errorf("quotedprintable.Writer.Write(): %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("quotedprintable.Writer.Close(): %w", err)
+	}
+
+	return nil
+}
+
+// wrap marks transient SMTP replies (4xx) and network failures as temporary,
+// so the message is retried instead of failed.
+func wrap(operation string, err error) error {
+	var protocolErr *textproto.Error
+	if errors.As(err, &protocolErr) {
+		if protocolErr.Code >= 400 && protocolErr.Code < 500 {
+			return fmt.Errorf("%s: %w: %w", operation, client.ErrTemporary, err)
+		}
+
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return fmt.Errorf("%s: %w: %w", operation, client.ErrTemporary, err)
+	}
+
+	return fmt.Errorf("%s: %w", operation, err)
+}
+
+func domainOf(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+
+	return "localhost"
+}
@@ -0,0 +1,384 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"messager/domain/message"
+	"messager/infrastructure/client"
+)
+
+// stubServer is a minimal SMTP server recording what it receives. It answers
+// every command with 250 unless a reply is configured for it.
+type stubServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	replies   map[string]string
+
+	mu       sync.Mutex
+	tls      bool
+	auth     string
+	from     string
+	to       []string
+	data     string
+	commands []string
+}
+
+func newStubServer(t *testing.T, tlsConfig *tls.Config, replies map[string]string) *stubServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &stubServer{
+		listener:  listener,
+		tlsConfig: tlsConfig,
+		replies:   replies,
+	}
+
+	t.Cleanup(func() { listener.Close() })
+
+	go server.serve()
+
+	return server
+}
+
+func (s *stubServer) port() uint16 {
+	return uint16(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *stubServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *stubServer) handle(conn net.Conn) {
+	defer func() { conn.Close() }()
+
+	reader := bufio.NewReader(conn)
+	write := func(line string) {
+		io.WriteString(conn, line+"\r\n")
+	}
+
+	write("220 localhost ESMTP stub")
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		s.mu.Lock()
+		s.commands = append(s.commands, verb)
+		s.mu.Unlock()
+
+		if reply, ok := s.replies[verb]; ok {
+			write(reply)
+			continue
+		}
+
+		switch verb {
+		case "EHLO", "HELO":
+			write("250-localhost")
+			if s.tlsConfig != nil && !s.isTLS() {
+				write("250-STARTTLS")
+			}
+			write("250 AUTH PLAIN")
+		case "STARTTLS":
+			write("220 ready to start TLS")
+
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+
+			conn = tlsConn
+			reader = bufio.NewReader(conn)
+
+			s.mu.Lock()
+			s.tls = true
+			s.mu.Unlock()
+		case "AUTH":
+			s.mu.Lock()
+			s.auth = line
+			s.mu.Unlock()
+			write("235 authenticated")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = line
+			s.mu.Unlock()
+			write("250 ok")
+		case "RCPT":
+			s.mu.Lock()
+			s.to = append(s.to, line)
+			s.mu.Unlock()
+			write("250 ok")
+		case "DATA":
+			write("354 end data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if dataLine == ".\r\n" {
+					break
+				}
+
+				data.WriteString(dataLine)
+			}
+
+			s.mu.Lock()
+			s.data = data.String()
+			s.mu.Unlock()
+
+			write("250 queued")
+		case "QUIT":
+			write("221 bye")
+			return
+		default:
+			write("250 ok")
+		}
+	}
+}
+
+func (s *stubServer) isTLS() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tls
+}
+
+func newTLSConfig(t *testing.T) *tls.Config {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{certificate}, PrivateKey: key}},
+	}
+}
+
+func validEmail() message.Message {
+	return message.Message{
+		Channel: message.ChannelEmail,
+		Email:   "jane@example.com",
+		Subject: "Your order has shipped",
+		Content: "Your order is on its way.",
+	}
+}
+
+func TestMailer_SendMessage(t *testing.T) {
+	server := newStubServer(t, nil, nil)
+
+	mailer := New(Config{
+		Host:    "127.0.0.1",
+		Port:    server.port(),
+		From:    "Shop <noreply@shop.example.com>",
+		Timeout: 5 * time.Second,
+	})
+
+	response, err := mailer.SendMessage(context.Background(), validEmail())
+	require.NoError(t, err)
+
+	id := response.ID
+
+	assert.True(t, strings.HasSuffix(id, "@shop.example.com"))
+	assert.Equal(t, replyOK, response.Status)
+	assert.Equal(t, "127.0.0.1", mailer.Provider())
+	assert.Equal(t, "MAIL FROM:<noreply@shop.example.com>", server.from)
+	assert.Equal(t, []string{"RCPT TO:<jane@example.com>"}, server.to)
+	assert.Empty(t, server.auth)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(server.data))
+	require.NoError(t, err)
+
+	assert.Equal(t, "jane@example.com", parsed.Header.Get("To"))
+	assert.Equal(t, "Your order has shipped", parsed.Header.Get("Subject"))
+	assert.Equal(t, "<"+id+">", parsed.Header.Get("Message-Id"))
+	assert.Equal(t, "text/plain; charset=utf-8", parsed.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(parsed.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "Your order is on its way.", strings.TrimSpace(string(body)))
+}
+
+func TestMailer_SendMessage_StartTLSAndAuth(t *testing.T) {
+	server := newStubServer(t, newTLSConfig(t), nil)
+
+	mailer := New(Config{
+		Host:          "127.0.0.1",
+		Port:          server.port(),
+		Username:      "user",
+		Password:      "secret",
+		From:          "noreply@shop.example.com",
+		RequireTLS:    true,
+		TLSSkipVerify: true,
+		Timeout:       5 * time.Second,
+	})
+
+	email := validEmail()
+	email.Subject = "Заказ отправлен"
+	email.HTMLContent = "<p>Your order is <b>on its way</b>.</p>"
+
+	_, err := mailer.SendMessage(context.Background(), email)
+	require.NoError(t, err)
+
+	assert.True(t, server.isTLS())
+	assert.Equal(t, "AUTH PLAIN "+base64.StdEncoding.EncodeToString([]byte("\x00user\x00secret")), server.auth)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(server.data))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Заказ отправлен", subject)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+
+	var contentTypes, contents []string
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+		contents = append(contents, string(content))
+	}
+
+	assert.Equal(t, []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}, contentTypes)
+	assert.Equal(t, []string{email.Content, email.HTMLContent}, contents)
+}
+
+func TestMailer_SendMessage_RequireTLSWithoutStartTLS(t *testing.T) {
+	server := newStubServer(t, nil, nil)
+
+	mailer := New(Config{
+		Host:       "127.0.0.1",
+		Port:       server.port(),
+		From:       "noreply@shop.example.com",
+		RequireTLS: true,
+		Timeout:    5 * time.Second,
+	})
+
+	_, err := mailer.SendMessage(context.Background(), validEmail())
+	require.Error(t, err)
+
+	assert.False(t, errors.Is(err, client.ErrTemporary))
+	assert.NotContains(t, server.commands, "MAIL")
+}
+
+func TestMailer_SendMessage_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		reply     string
+		temporary bool
+	}{
+		{
+			name:      "temporary",
+			reply:     "451 try again later",
+			temporary: true,
+		},
+		{
+			name:      "permanent",
+			reply:     "550 no such user",
+			temporary: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStubServer(t, nil, map[string]string{"RCPT": tt.reply})
+
+			mailer := New(Config{
+				Host:    "127.0.0.1",
+				Port:    server.port(),
+				From:    "noreply@shop.example.com",
+				Timeout: 5 * time.Second,
+			})
+
+			response, err := mailer.SendMessage(context.Background(), validEmail())
+			require.Error(t, err)
+
+			assert.Equal(t, tt.temporary, errors.Is(err, client.ErrTemporary))
+			assert.Contains(t, err.Error(), tt.reply[:3])
+			assert.Equal(t, tt.reply[:3], strconv.Itoa(response.Status))
+		})
+	}
+}
+
+func TestMailer_SendMessage_Unreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	mailer := New(Config{
+		Host:    "127.0.0.1",
+		Port:    uint16(port),
+		From:    "noreply@shop.example.com",
+		Timeout: 5 * time.Second,
+	})
+
+	_, err = mailer.SendMessage(context.Background(), validEmail())
+	require.Error(t, err)
+
+	assert.True(t, errors.Is(err, client.ErrTemporary))
+}
@@ -0,0 +1,23 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/apikey"
+)
+
+func (p *persistence) Create(ctx context.Context, key *apikey.APIKey) error {
+	query := `
+		INSERT INTO api_keys (tenant_id, name, role, prefix, hash)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, key.TenantID, key.Name, key.Role, key.Prefix, key.Hash)
+
+	if err := row.Scan(&key.ID, &key.CreatedAt); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+)
+
+func (p *persistence) Delete(ctx context.Context, tenantID, id string) error {
+	query := `
+		DELETE FROM api_keys
+		WHERE tenant_id = $1 AND id = $2
+		RETURNING id;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, tenantID, id)
+
+	if err := row.Scan(&id); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
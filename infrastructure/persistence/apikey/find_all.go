@@ -0,0 +1,41 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/apikey"
+)
+
+func (p *persistence) FindAll(ctx context.Context, tenantID string) ([]apikey.APIKey, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM api_keys
+		WHERE tenant_id = $1
+		ORDER BY created_at, name;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []apikey.APIKey
+
+	for rows.Next() {
+		var record apikey.APIKey
+
+		if err := scan(rows, &record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
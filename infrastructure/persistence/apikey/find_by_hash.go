@@ -0,0 +1,25 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/apikey"
+)
+
+func (p *persistence) FindByHash(ctx context.Context, hash string) (*apikey.APIKey, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM api_keys
+		WHERE hash = $1;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, hash)
+
+	var record apikey.APIKey
+
+	if err := scan(row, &record); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return &record, nil
+}
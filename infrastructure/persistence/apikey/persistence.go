@@ -0,0 +1,18 @@
+package apikey
+
+import (
+	"messager/domain/apikey"
+	"messager/infrastructure/database/postgresql"
+)
+
+type persistence struct {
+	postgreSQL postgresql.PostgreSQL
+}
+
+func New(postgreSQL postgresql.PostgreSQL) apikey.Repository {
+	p := persistence{
+		postgreSQL: postgreSQL,
+	}
+
+	return &p
+}
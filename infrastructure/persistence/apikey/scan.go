@@ -0,0 +1,15 @@
+package apikey
+
+import (
+	"messager/domain/apikey"
+)
+
+const columns = `id, tenant_id, created_at, name, role, prefix, hash`
+
+type scanner interface {
+	Scan(destination ...any) error
+}
+
+func scan(scanner scanner, record *apikey.APIKey) error {
+	return scanner.Scan(&record.ID, &record.TenantID, &record.CreatedAt, &record.Name, &record.Role, &record.Prefix, &record.Hash)
+}
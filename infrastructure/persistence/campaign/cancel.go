@@ -0,0 +1,49 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+)
+
+// Cancel cancels the campaign together with its messages that have not been
+// claimed for sending, recording an event and an outbox entry for every cancelled message.
+func (p *persistence) Cancel(ctx context.Context, tenantID, id string, from campaign.Status) error {
+	query := `
+		WITH updated AS (
+			UPDATE campaigns
+			SET status = $1, updated_at = now()
+			WHERE tenant_id = $2 AND id = $3 AND status = $4
+			RETURNING id
+		), cancelled AS (
+			UPDATE messages
+			SET status = $5, updated_at = now()
+			FROM (
+				SELECT id, status
+				FROM messages
+				WHERE tenant_id = $2 AND campaign_id = $3 AND status IN ($6, $7)
+				FOR UPDATE
+			) previous
+			WHERE messages.id = previous.id AND EXISTS (SELECT 1 FROM updated)
+			RETURNING messages.id, messages.tenant_id, previous.status AS from_status, messages.status AS to_status
+		), events AS (
+			INSERT INTO message_events (message_id, from_status, to_status)
+			SELECT id, from_status, to_status FROM cancelled
+		), outbox AS (
+			INSERT INTO message_outbox (message_id, tenant_id, from_status, to_status)
+			SELECT id, tenant_id, from_status, to_status FROM cancelled
+		)
+		SELECT id FROM updated;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query,
+		campaign.StatusCancelled, tenantID, id, from,
+		message.StatusCancelled, message.StatusPending, message.StatusQueued)
+
+	if err := row.Scan(&id); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
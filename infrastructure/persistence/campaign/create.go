@@ -0,0 +1,34 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/campaign"
+)
+
+func (p *persistence) Create(ctx context.Context, campaign *campaign.Campaign) error {
+	variables, err := nullableJSON(campaign.Variables)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO campaigns (
+			name, status, content, template_id, locale, variables, send_at, time_zone, valid_until, priority,
+			recipient_count, category, tenant_id
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, created_at, updated_at;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query,
+		campaign.Name, campaign.Status, campaign.Content, nullableString(campaign.TemplateID), campaign.Locale,
+		variables, nullableTime(campaign.SendAt), campaign.TimeZone, nullableTime(campaign.ValidUntil),
+		campaign.Priority, campaign.RecipientCount, campaign.Category, campaign.TenantID)
+
+	if err := row.Scan(&campaign.ID, &campaign.CreatedAt, &campaign.UpdatedAt); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
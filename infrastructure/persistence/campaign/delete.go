@@ -0,0 +1,26 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+)
+
+// Delete removes the campaign together with its messages.
+func (p *persistence) Delete(ctx context.Context, tenantID, id string) error {
+	query := `
+		WITH deleted AS (
+			DELETE FROM messages
+			WHERE tenant_id = $1 AND campaign_id = $2
+		)
+		DELETE FROM campaigns
+		WHERE tenant_id = $1 AND id = $2
+		RETURNING id;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, tenantID, id)
+
+	if err := row.Scan(&id); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/campaign"
+)
+
+func (p *persistence) FindAll(ctx context.Context, tenantID string) ([]campaign.Campaign, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM campaigns
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []campaign.Campaign
+
+	for rows.Next() {
+		var record campaign.Campaign
+
+		if err := scan(rows, &record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,25 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/campaign"
+)
+
+func (p *persistence) FindByID(ctx context.Context, tenantID, id string) (*campaign.Campaign, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM campaigns
+		WHERE tenant_id = $1 AND id = $2
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, tenantID, id)
+
+	var record campaign.Campaign
+
+	if err := scan(row, &record); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return &record, nil
+}
@@ -0,0 +1,45 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+)
+
+func (p *persistence) FindProgressByID(ctx context.Context, tenantID, id string) (campaign.Progress, error) {
+	query := `
+		SELECT status, count(*)
+		FROM messages
+		WHERE tenant_id = $1 AND campaign_id = $2
+		GROUP BY status;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID, id)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	progress := make(campaign.Progress)
+
+	for rows.Next() {
+		var (
+			status message.Status
+			count  int
+		)
+
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		progress[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,18 @@
+package campaign
+
+import (
+	"messager/domain/campaign"
+	"messager/infrastructure/database/postgresql"
+)
+
+type persistence struct {
+	postgreSQL postgresql.PostgreSQL
+}
+
+func New(postgreSQL postgresql.PostgreSQL) campaign.Repository {
+	p := persistence{
+		postgreSQL: postgreSQL,
+	}
+
+	return &p
+}
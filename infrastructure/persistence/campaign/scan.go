@@ -0,0 +1,81 @@
+package campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"messager/domain/campaign"
+)
+
+const columns = `id, tenant_id, created_at, updated_at, name, status, content, template_id, locale, variables, send_at, time_zone, valid_until, priority, recipient_count, category`
+
+type scanner interface {
+	Scan(destination ...any) error
+}
+
+func scan(scanner scanner, record *campaign.Campaign) error {
+	var (
+		templateID *string
+		variables  []byte
+		sendAt     *time.Time
+		validUntil *time.Time
+	)
+
+	if err := scanner.Scan(
+		&record.ID, &record.TenantID, &record.CreatedAt, &record.UpdatedAt, &record.Name, &record.Status, &record.Content,
+		&templateID, &record.Locale, &variables, &sendAt, &record.TimeZone, &validUntil, &record.Priority,
+		&record.RecipientCount, &record.Category,
+	); err != nil {
+		return err
+	}
+
+	if templateID != nil {
+		record.TemplateID = *templateID
+	}
+
+	if variables != nil {
+		if err := json.Unmarshal(variables, &record.Variables); err != nil {
+			return fmt.Errorf("json.Unmarshal(): %w", err)
+		}
+	}
+
+	if sendAt != nil {
+		record.SendAt = *sendAt
+	}
+
+	if validUntil != nil {
+		record.ValidUntil = *validUntil
+	}
+
+	return nil
+}
+
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func nullableTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+
+	return &value
+}
+
+func nullableJSON(value map[string]string) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,24 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/campaign"
+)
+
+func (p *persistence) UpdateStatus(ctx context.Context, tenantID, id string, from, to campaign.Status) error {
+	query := `
+		UPDATE campaigns
+		SET status = $1, updated_at = now()
+		WHERE tenant_id = $2 AND id = $3 AND status = $4
+		RETURNING id;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, to, tenantID, id, from)
+
+	if err := row.Scan(&id); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package consent
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/consent"
+)
+
+func (p *persistence) Create(ctx context.Context, consent *consent.Consent) error {
+	query := `
+		INSERT INTO recipient_consents (tenant_id, phone, status, source, category)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, consent.TenantID, consent.Phone, consent.Status, consent.Source, consent.Category)
+
+	if err := row.Scan(&consent.ID, &consent.CreatedAt); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package consent
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/consent"
+)
+
+func (p *persistence) FindAllByPhone(ctx context.Context, tenantID, phone string) ([]consent.Consent, error) {
+	query := `
+		SELECT id, tenant_id, created_at, phone, status, source, category
+		FROM recipient_consents
+		WHERE tenant_id = $1 AND phone = $2
+		ORDER BY created_at DESC;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID, phone)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []consent.Consent
+
+	for rows.Next() {
+		var record consent.Consent
+
+		if err := rows.Scan(&record.ID, &record.TenantID, &record.CreatedAt, &record.Phone, &record.Status, &record.Source, &record.Category); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,48 @@
+package consent
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/consent"
+	"messager/domain/message"
+)
+
+// FindAllOptedOutPhones returns the phones whose latest consent entry of the
+// tenant for the category is an opt-out.
+func (p *persistence) FindAllOptedOutPhones(ctx context.Context, tenantID string, phones []string, category message.Category) ([]string, error) {
+	query := `
+		SELECT phone
+		FROM (
+			SELECT DISTINCT ON (phone) phone, status
+			FROM recipient_consents
+			WHERE tenant_id = $1 AND phone = ANY($2) AND category = $3
+			ORDER BY phone, created_at DESC
+		) latest
+		WHERE status = $4;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID, phones, category, consent.StatusOptedOut)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []string
+
+	for rows.Next() {
+		var record string
+
+		if err := rows.Scan(&record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
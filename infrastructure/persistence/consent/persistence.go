@@ -0,0 +1,18 @@
+package consent
+
+import (
+	"messager/domain/consent"
+	"messager/infrastructure/database/postgresql"
+)
+
+type persistence struct {
+	postgreSQL postgresql.PostgreSQL
+}
+
+func New(postgreSQL postgresql.PostgreSQL) consent.Repository {
+	p := persistence{
+		postgreSQL: postgreSQL,
+	}
+
+	return &p
+}
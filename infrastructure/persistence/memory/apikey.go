@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"messager/domain/apikey"
+)
+
+type apiKeyRepository struct {
+	store *Store
+}
+
+func NewAPIKeyRepository(store *Store) apikey.Repository {
+	return &apiKeyRepository{
+		store: store,
+	}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *apikey.APIKey) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, record := range r.store.apiKeys {
+		if record.Hash == key.Hash {
+			return errors.New("memory.Store.apiKeys: hash must be unique")
+		}
+	}
+
+	key.ID = newID()
+	key.CreatedAt = time.Now().UTC()
+
+	record := *key
+	record.Secret = ""
+
+	r.store.apiKeys[key.ID] = record
+
+	return nil
+}
+
+func (r *apiKeyRepository) FindAll(ctx context.Context, tenantID string) ([]apikey.APIKey, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var records []apikey.APIKey
+
+	for _, record := range r.store.apiKeys {
+		if record.TenantID == tenantID {
+			records = append(records, record)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b apikey.APIKey) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.Name, b.Name))
+	})
+
+	return records, nil
+}
+
+func (r *apiKeyRepository) FindByHash(ctx context.Context, hash string) (*apikey.APIKey, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, record := range r.store.apiKeys {
+		if record.Hash == hash {
+			return &record, nil
+		}
+	}
+
+	return nil, errNoRows("apiKeys")
+}
+
+func (r *apiKeyRepository) Delete(ctx context.Context, tenantID, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.apiKeys[id]
+	if !ok || record.TenantID != tenantID {
+		return errNoRows("apiKeys")
+	}
+
+	delete(r.store.apiKeys, id)
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+)
+
+type campaignRepository struct {
+	store *Store
+}
+
+func NewCampaignRepository(store *Store) campaign.Repository {
+	return &campaignRepository{
+		store: store,
+	}
+}
+
+func (r *campaignRepository) Create(ctx context.Context, campaign *campaign.Campaign) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	campaign.ID = newID()
+	campaign.CreatedAt = now
+	campaign.UpdatedAt = now
+
+	r.store.campaigns[campaign.ID] = cloneCampaign(*campaign)
+
+	return nil
+}
+
+func (r *campaignRepository) FindAll(ctx context.Context, tenantID string) ([]campaign.Campaign, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var records []campaign.Campaign
+
+	for _, record := range r.store.campaigns {
+		if record.TenantID == tenantID {
+			records = append(records, cloneCampaign(record))
+		}
+	}
+
+	slices.SortFunc(records, func(a, b campaign.Campaign) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return records, nil
+}
+
+func (r *campaignRepository) FindByID(ctx context.Context, tenantID, id string) (*campaign.Campaign, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.campaigns[id]
+	if !ok || record.TenantID != tenantID {
+		return nil, errNoRows("campaigns")
+	}
+
+	record = cloneCampaign(record)
+
+	return &record, nil
+}
+
+func (r *campaignRepository) FindProgressByID(ctx context.Context, tenantID, id string) (campaign.Progress, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	progress := make(campaign.Progress)
+
+	for _, record := range r.store.messages {
+		if record.TenantID == tenantID && record.CampaignID == id {
+			progress[record.Status]++
+		}
+	}
+
+	return progress, nil
+}
+
+func (r *campaignRepository) UpdateStatus(ctx context.Context, tenantID, id string, from, to campaign.Status) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.campaigns[id]
+	if !ok || record.TenantID != tenantID || record.Status != from {
+		return errNoRows("campaigns")
+	}
+
+	record.Status = to
+	record.UpdatedAt = time.Now().UTC()
+
+	r.store.campaigns[id] = record
+
+	return nil
+}
+
+// Cancel cancels the campaign together with its messages that have not been
+// claimed for sending, recording an event and an outbox entry for every
+// cancelled message.
+func (r *campaignRepository) Cancel(ctx context.Context, tenantID, id string, from campaign.Status) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.campaigns[id]
+	if !ok || record.TenantID != tenantID || record.Status != from {
+		return errNoRows("campaigns")
+	}
+
+	now := time.Now().UTC()
+
+	record.Status = campaign.StatusCancelled
+	record.UpdatedAt = now
+
+	r.store.campaigns[id] = record
+
+	for _, cancelled := range r.store.sortedMessages() {
+		if cancelled.CampaignID == id && (cancelled.Status == message.StatusPending || cancelled.Status == message.StatusQueued) {
+			r.store.changeStatus(cancelled, message.StatusCancelled, now)
+		}
+	}
+
+	return nil
+}
+
+// Delete removes the campaign together with its messages.
+func (r *campaignRepository) Delete(ctx context.Context, tenantID, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.campaigns[id]
+	if !ok || record.TenantID != tenantID {
+		return errNoRows("campaigns")
+	}
+
+	for _, stored := range r.store.messages {
+		if stored.CampaignID == id {
+			r.store.deleteMessage(stored.ID)
+		}
+	}
+
+	delete(r.store.campaigns, id)
+
+	return nil
+}
+
+// cloneCampaign copies the columns of a campaign, the recipients, rejections
+// and progress are not stored with it.
+func cloneCampaign(record campaign.Campaign) campaign.Campaign {
+	record.Variables = maps.Clone(record.Variables)
+	record.Recipients = nil
+	record.Rejections = nil
+	record.Progress = nil
+
+	return record
+}
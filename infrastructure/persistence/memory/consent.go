@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"messager/domain/consent"
+	"messager/domain/message"
+)
+
+type consentRepository struct {
+	store *Store
+}
+
+func NewConsentRepository(store *Store) consent.Repository {
+	return &consentRepository{
+		store: store,
+	}
+}
+
+func (r *consentRepository) Create(ctx context.Context, consent *consent.Consent) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	consent.ID = newID()
+	consent.CreatedAt = time.Now().UTC()
+
+	r.store.consents = append(r.store.consents, *consent)
+
+	return nil
+}
+
+func (r *consentRepository) FindAllByPhone(ctx context.Context, tenantID, phone string) ([]consent.Consent, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var records []consent.Consent
+
+	// Consents are appended as they are created, so the latest comes first
+	// when they are read backwards.
+	for _, record := range slices.Backward(r.store.consents) {
+		if record.TenantID == tenantID && record.Phone == phone {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// FindAllOptedOutPhones returns the phones whose latest consent entry of the
+// tenant for the category is an opt-out.
+func (r *consentRepository) FindAllOptedOutPhones(ctx context.Context, tenantID string, phones []string, category message.Category) ([]string, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	latest := make(map[string]consent.Status, len(phones))
+
+	for _, record := range r.store.consents {
+		if record.TenantID == tenantID && record.Category == category && slices.Contains(phones, record.Phone) {
+			latest[record.Phone] = record.Status
+		}
+	}
+
+	var records []string
+
+	for _, phone := range phones {
+		if status, ok := latest[phone]; ok && status == consent.StatusOptedOut && !slices.Contains(records, phone) {
+			records = append(records, phone)
+		}
+	}
+
+	return records, nil
+}
@@ -0,0 +1,439 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+	"messager/infrastructure/database/redis"
+	messagepersistence "messager/infrastructure/persistence/message"
+)
+
+type messageRepository struct {
+	store *Store
+	// keys keeps the idempotency keys and quotas, which live in Redis rather
+	// than in PostgreSQL, so the message persistence handles them with the
+	// Redis it is given. Its PostgreSQL is never used.
+	keys message.Repository
+}
+
+// NewMessageRepository returns a message repository of the store, keeping
+// idempotency keys and quotas in redis.
+func NewMessageRepository(store *Store, redis redis.Redis) message.Repository {
+	return &messageRepository{
+		store: store,
+		keys:  messagepersistence.New(nil, redis, messagepersistence.Config{}),
+	}
+}
+
+func (r *messageRepository) Create(ctx context.Context, message *message.Message) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.addMessage(message, time.Now().UTC())
+
+	return nil
+}
+
+func (r *messageRepository) CreateAll(ctx context.Context, messages []*message.Message) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	for _, message := range messages {
+		r.store.addMessage(message, now)
+	}
+
+	return nil
+}
+
+// FindAllByStatus returns a page of the messages of a tenant narrowed by the
+// filter, newest first. The content search matches the messages containing
+// every word of the query, which approximates the full-text search of
+// PostgreSQL.
+func (r *messageRepository) FindAllByStatus(ctx context.Context, filter message.ListFilter) (*message.Page, error) {
+	var cursor *message.Cursor
+
+	if filter.Cursor != "" {
+		parsed, err := message.ParseCursor(filter.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("message.ParseCursor(): %w", err)
+		}
+
+		cursor = &parsed
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	page := message.Page{
+		Items: make([]message.Message, 0, filter.Limit),
+	}
+
+	records := r.store.sortedMessages()
+
+	for _, record := range slices.Backward(records) {
+		if !matches(record, filter) {
+			continue
+		}
+
+		if cursor != nil && compareMessage(record, cursor.CreatedAt, cursor.ID) >= 0 {
+			continue
+		}
+
+		if len(page.Items) == filter.Limit {
+			page.NextCursor = message.NewCursor(page.Items[filter.Limit-1])
+
+			break
+		}
+
+		page.Items = append(page.Items, cloneMessage(record))
+	}
+
+	return &page, nil
+}
+
+func (r *messageRepository) FindByID(ctx context.Context, tenantID, id string) (*message.Message, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.messages[id]
+	if !ok || record.TenantID != tenantID {
+		return nil, errNoRows("messages")
+	}
+
+	record = cloneMessage(record)
+
+	return &record, nil
+}
+
+// ClaimAllByStatusAndPriority moves up to limit due messages of a priority
+// from a status to another, skipping the messages of campaigns that are not
+// running and of paused tenants.
+func (r *messageRepository) ClaimAllByStatusAndPriority(ctx context.Context, from, to message.Status, priority message.Priority, limit int) ([]message.Message, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	var claimable []message.Message
+
+	for _, record := range r.store.messages {
+		if record.Status != from || record.Priority != priority || record.SendAt.After(now) {
+			continue
+		}
+
+		if !record.ValidUntil.IsZero() && !record.ValidUntil.After(now) {
+			continue
+		}
+
+		if record.CampaignID != "" && r.store.campaigns[record.CampaignID].Status != campaign.StatusRunning {
+			continue
+		}
+
+		if r.store.tenants[record.TenantID].Paused {
+			continue
+		}
+
+		claimable = append(claimable, record)
+	}
+
+	slices.SortFunc(claimable, func(a, b message.Message) int {
+		return cmp.Or(a.SendAt.Compare(b.SendAt), a.CreatedAt.Compare(b.CreatedAt))
+	})
+
+	var records []message.Message
+
+	for _, record := range claimable[:min(limit, len(claimable))] {
+		records = append(records, cloneMessage(r.store.changeStatus(record, to, now)))
+	}
+
+	return records, nil
+}
+
+func (r *messageRepository) UpdateStatus(ctx context.Context, tenantID, id string, from, to message.Status) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.messages[id]
+	if !ok || record.TenantID != tenantID || record.Status != from {
+		return errNoRows("messages")
+	}
+
+	r.store.changeStatus(record, to, time.Now().UTC())
+
+	return nil
+}
+
+func (r *messageRepository) Reschedule(ctx context.Context, tenantID, id string, from message.Status, sendAt time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.messages[id]
+	if !ok || record.TenantID != tenantID || record.Status != from {
+		return errNoRows("messages")
+	}
+
+	record.SendAt = sendAt.UTC()
+
+	r.store.changeStatus(record, message.StatusQueued, time.Now().UTC())
+
+	return nil
+}
+
+func (r *messageRepository) ExpireAllByStatus(ctx context.Context, status message.Status) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	for _, record := range r.store.sortedMessages() {
+		if record.Status == status && !record.ValidUntil.IsZero() && !record.ValidUntil.After(now) {
+			r.store.changeStatus(record, message.StatusExpired, now)
+		}
+	}
+
+	return nil
+}
+
+// RequeueAllByStatus moves the messages that have been in status since before
+// updatedBefore back to PENDING, so that they are claimed again.
+func (r *messageRepository) RequeueAllByStatus(ctx context.Context, status message.Status, updatedBefore time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	for _, record := range r.store.sortedMessages() {
+		if record.Status == status && record.UpdatedAt.Before(updatedBefore) {
+			r.store.changeStatus(record, message.StatusPending, now)
+		}
+	}
+
+	return nil
+}
+
+func (r *messageRepository) CreateAttempt(ctx context.Context, attempt *message.Attempt) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.messages[attempt.MessageID]; !ok {
+		return errNoRows("messages")
+	}
+
+	attempt.ID = newID()
+	attempt.CreatedAt = time.Now().UTC()
+
+	r.store.attempts = append(r.store.attempts, *attempt)
+
+	return nil
+}
+
+func (r *messageRepository) FindAllAttemptsByMessageID(ctx context.Context, tenantID, messageID string) ([]message.Attempt, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if record, ok := r.store.messages[messageID]; !ok || record.TenantID != tenantID {
+		return nil, nil
+	}
+
+	var records []message.Attempt
+
+	for _, attempt := range r.store.attempts {
+		if attempt.MessageID == messageID {
+			records = append(records, attempt)
+		}
+	}
+
+	return records, nil
+}
+
+func (r *messageRepository) FindAllEventsByMessageID(ctx context.Context, tenantID, messageID string) ([]message.Event, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if record, ok := r.store.messages[messageID]; !ok || record.TenantID != tenantID {
+		return nil, nil
+	}
+
+	var records []message.Event
+
+	for _, event := range r.store.events {
+		if event.MessageID == messageID {
+			records = append(records, event)
+		}
+	}
+
+	return records, nil
+}
+
+func (r *messageRepository) ReserveIdempotencyKey(ctx context.Context, record message.IdempotencyRecord, ttl time.Duration) (*message.IdempotencyRecord, error) {
+	return r.keys.ReserveIdempotencyKey(ctx, record, ttl)
+}
+
+func (r *messageRepository) CompleteIdempotencyKey(ctx context.Context, record message.IdempotencyRecord, ttl time.Duration) error {
+	return r.keys.CompleteIdempotencyKey(ctx, record, ttl)
+}
+
+func (r *messageRepository) ReleaseIdempotencyKey(ctx context.Context, tenantID, key string) error {
+	return r.keys.ReleaseIdempotencyKey(ctx, tenantID, key)
+}
+
+func (r *messageRepository) ReserveQuota(ctx context.Context, tenantID string, day time.Time, count, limit int) (bool, error) {
+	return r.keys.ReserveQuota(ctx, tenantID, day, count, limit)
+}
+
+func (r *messageRepository) ReleaseQuota(ctx context.Context, tenantID string, day time.Time, count int) error {
+	return r.keys.ReleaseQuota(ctx, tenantID, day, count)
+}
+
+// RelayOutbox hands the oldest entries of the outbox to relay and deletes them
+// once it succeeds. Only one relay runs at a time, and the store is not locked
+// while relay runs so that publishing does not block the other writers.
+func (r *messageRepository) RelayOutbox(ctx context.Context, limit int, relay func(entries []message.OutboxEntry) error) error {
+	if !r.store.relaying.TryLock() {
+		return nil
+	}
+
+	defer r.store.relaying.Unlock()
+
+	r.store.mu.Lock()
+	entries := slices.Clone(r.store.outbox[:min(limit, len(r.store.outbox))])
+	r.store.mu.Unlock()
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := relay(entries); err != nil {
+		return err
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Entries are only appended while relay runs, so the relayed ones are
+	// still the oldest.
+	r.store.outbox = slices.Delete(r.store.outbox, 0, len(entries))
+
+	return nil
+}
+
+// addMessage stores a new message with its created event and outbox entry.
+// The caller holds the lock.
+func (s *Store) addMessage(record *message.Message, now time.Time) {
+	record.ID = newID()
+	record.CreatedAt = now
+	record.UpdatedAt = now
+
+	if record.Metadata == nil {
+		record.Metadata = make(map[string]string)
+	}
+
+	if record.Tags == nil {
+		record.Tags = make([]string, 0)
+	}
+
+	s.messages[record.ID] = cloneMessage(*record)
+
+	s.addEvent(*record, "", now)
+}
+
+// sortedMessages returns the messages oldest first, by creation time and ID
+// like the keyset of the listing. The caller holds the lock.
+func (s *Store) sortedMessages() []message.Message {
+	records := slices.Collect(maps.Values(s.messages))
+
+	slices.SortFunc(records, func(a, b message.Message) int {
+		return compareMessage(a, b.CreatedAt, b.ID)
+	})
+
+	return records
+}
+
+func compareMessage(record message.Message, createdAt time.Time, id string) int {
+	return cmp.Or(record.CreatedAt.Compare(createdAt), cmp.Compare(record.ID, id))
+}
+
+// matches reports whether a message is in the list narrowed by the filter.
+func matches(record message.Message, filter message.ListFilter) bool {
+	if record.TenantID != filter.TenantID {
+		return false
+	}
+
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, record.Status) {
+		return false
+	}
+
+	if filter.Phone != "" && record.Phone != filter.Phone {
+		return false
+	}
+
+	if !filter.CreatedFrom.IsZero() && record.CreatedAt.Before(filter.CreatedFrom) {
+		return false
+	}
+
+	if !filter.CreatedTo.IsZero() && !record.CreatedAt.Before(filter.CreatedTo) {
+		return false
+	}
+
+	if !filter.UpdatedFrom.IsZero() && record.UpdatedAt.Before(filter.UpdatedFrom) {
+		return false
+	}
+
+	if !filter.UpdatedTo.IsZero() && !record.UpdatedAt.Before(filter.UpdatedTo) {
+		return false
+	}
+
+	if filter.Query != "" {
+		words := words(record.Content)
+
+		for _, word := range strings.FieldsFunc(strings.ToLower(filter.Query), isSeparator) {
+			if !slices.Contains(words, word) {
+				return false
+			}
+		}
+	}
+
+	if filter.Tag != "" && !slices.Contains(record.Tags, filter.Tag) {
+		return false
+	}
+
+	if filter.MetadataKey != "" {
+		value, ok := record.Metadata[filter.MetadataKey]
+		if !ok || (filter.MetadataValue != "" && value != filter.MetadataValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func words(content string) []string {
+	return strings.FieldsFunc(strings.ToLower(content), isSeparator)
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// cloneMessage copies the columns of a message, the fields that are not
+// stored with it are left out.
+func cloneMessage(record message.Message) message.Message {
+	record.Metadata = maps.Clone(record.Metadata)
+	record.Tags = slices.Clone(record.Tags)
+	record.TimeZone = ""
+	record.Variables = nil
+	record.IdempotencyKey = ""
+	record.Attempts = nil
+
+	return record
+}
@@ -0,0 +1,262 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+	"messager/infrastructure/database/redis"
+)
+
+func TestMessageRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repository := NewMessageRepository(store, redis.NewMemory())
+
+	created := message.Message{TenantID: tenant.DefaultID, Content: "Hello", Status: message.StatusPending}
+	assert.NoError(t, repository.Create(ctx, &created))
+	assert.NotEmpty(t, created.ID)
+	assert.False(t, created.CreatedAt.IsZero())
+
+	found, err := repository.FindByID(ctx, tenant.DefaultID, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", found.Content)
+	assert.Empty(t, found.Metadata)
+	assert.NotNil(t, found.Metadata)
+
+	_, err = repository.FindByID(ctx, "other-tenant", created.ID)
+	assert.ErrorIs(t, err, postgresql.ErrNoRows)
+
+	events, err := repository.FindAllEventsByMessageID(ctx, tenant.DefaultID, created.ID)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, message.StatusPending, events[0].To)
+
+	assert.ErrorIs(t, repository.UpdateStatus(ctx, tenant.DefaultID, created.ID, message.StatusSending, message.StatusSent), postgresql.ErrNoRows)
+}
+
+func TestMessageRepository_ClaimAllByStatusAndPriority(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repository := NewMessageRepository(store, redis.NewMemory())
+	tenants := NewTenantRepository(store)
+	campaigns := NewCampaignRepository(store)
+
+	paused := tenant.Tenant{Name: "paused"}
+	assert.NoError(t, tenants.Create(ctx, &paused))
+	assert.NoError(t, tenants.UpdatePaused(ctx, paused.ID, true))
+
+	draft := campaign.Campaign{TenantID: tenant.DefaultID, Status: campaign.StatusDraft}
+	assert.NoError(t, campaigns.Create(ctx, &draft))
+
+	now := time.Now().UTC()
+
+	messages := []*message.Message{
+		{TenantID: tenant.DefaultID, Content: "later", SendAt: now.Add(-time.Minute)},
+		{TenantID: tenant.DefaultID, Content: "first", SendAt: now.Add(-time.Hour)},
+		{TenantID: tenant.DefaultID, Content: "scheduled", SendAt: now.Add(time.Hour)},
+		{TenantID: tenant.DefaultID, Content: "expired", SendAt: now.Add(-time.Hour), ValidUntil: now.Add(-time.Minute)},
+		{TenantID: tenant.DefaultID, Content: "bulk", SendAt: now.Add(-time.Hour), Priority: message.PriorityBulk},
+		{TenantID: tenant.DefaultID, Content: "draft", SendAt: now.Add(-time.Hour), CampaignID: draft.ID},
+		{TenantID: paused.ID, Content: "paused", SendAt: now.Add(-time.Hour)},
+	}
+
+	for _, created := range messages {
+		created.Status = message.StatusPending
+		if created.Priority == "" {
+			created.Priority = message.PriorityNormal
+		}
+	}
+
+	assert.NoError(t, repository.CreateAll(ctx, messages))
+
+	claimed, err := repository.ClaimAllByStatusAndPriority(ctx, message.StatusPending, message.StatusSending, message.PriorityNormal, 10)
+	assert.NoError(t, err)
+
+	var contents []string
+	for _, record := range claimed {
+		assert.Equal(t, message.StatusSending, record.Status)
+		contents = append(contents, record.Content)
+	}
+
+	assert.Equal(t, []string{"first", "later"}, contents)
+
+	claimed, err = repository.ClaimAllByStatusAndPriority(ctx, message.StatusPending, message.StatusSending, message.PriorityNormal, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+}
+
+func TestMessageRepository_RequeueAllByStatus(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repository := NewMessageRepository(store, redis.NewMemory())
+
+	created := message.Message{TenantID: tenant.DefaultID, Status: message.StatusPending}
+	assert.NoError(t, repository.Create(ctx, &created))
+	assert.NoError(t, repository.UpdateStatus(ctx, tenant.DefaultID, created.ID, message.StatusPending, message.StatusSending))
+
+	assert.NoError(t, repository.RequeueAllByStatus(ctx, message.StatusSending, time.Now().Add(-time.Minute)))
+
+	found, err := repository.FindByID(ctx, tenant.DefaultID, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, message.StatusSending, found.Status)
+
+	assert.NoError(t, repository.RequeueAllByStatus(ctx, message.StatusSending, time.Now().Add(time.Minute)))
+
+	found, err = repository.FindByID(ctx, tenant.DefaultID, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, message.StatusPending, found.Status)
+
+	events, err := repository.FindAllEventsByMessageID(ctx, tenant.DefaultID, created.ID)
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, message.StatusSending, events[2].From)
+	assert.Equal(t, message.StatusPending, events[2].To)
+}
+
+func TestMessageRepository_FindAllByStatus(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repository := NewMessageRepository(store, redis.NewMemory())
+
+	for _, content := range []string{"Your code is 1234", "Your order shipped", "Your code is 5678"} {
+		created := message.Message{
+			TenantID: tenant.DefaultID,
+			Content:  content,
+			Status:   message.StatusPending,
+			Tags:     []string{"otp"},
+		}
+		assert.NoError(t, repository.Create(ctx, &created))
+	}
+
+	page, err := repository.FindAllByStatus(ctx, message.ListFilter{
+		TenantID: tenant.DefaultID,
+		Query:    "code",
+		Tag:      "otp",
+		Limit:    1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, "Your code is 5678", page.Items[0].Content)
+	assert.NotEmpty(t, page.NextCursor)
+
+	page, err = repository.FindAllByStatus(ctx, message.ListFilter{
+		TenantID: tenant.DefaultID,
+		Query:    "code",
+		Limit:    1,
+		Cursor:   page.NextCursor,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, "Your code is 1234", page.Items[0].Content)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = repository.FindAllByStatus(ctx, message.ListFilter{
+		TenantID: tenant.DefaultID,
+		Statuses: []message.Status{message.StatusSent},
+		Limit:    10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, page.Items)
+}
+
+func TestMessageRepository_RelayOutbox(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repository := NewMessageRepository(store, redis.NewMemory())
+
+	created := message.Message{TenantID: tenant.DefaultID, Status: message.StatusPending}
+	assert.NoError(t, repository.Create(ctx, &created))
+	assert.NoError(t, repository.UpdateStatus(ctx, tenant.DefaultID, created.ID, message.StatusPending, message.StatusSending))
+
+	assert.Error(t, repository.RelayOutbox(ctx, 10, func(entries []message.OutboxEntry) error {
+		return errors.New("publish failed")
+	}))
+
+	var relayed []message.OutboxEntry
+
+	err := repository.RelayOutbox(ctx, 10, func(entries []message.OutboxEntry) error {
+		relayed = entries
+
+		// A status change recorded while the outbox is relayed is kept for
+		// the next run.
+		return repository.UpdateStatus(ctx, tenant.DefaultID, created.ID, message.StatusSending, message.StatusSent)
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relayed, 2)
+	assert.True(t, relayed[1].IsDispatch())
+
+	err = repository.RelayOutbox(ctx, 10, func(entries []message.OutboxEntry) error {
+		relayed = entries
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relayed, 1)
+	assert.Equal(t, message.StatusSent, relayed[0].To)
+}
+
+func TestCampaignRepository_Cancel(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repository := NewMessageRepository(store, redis.NewMemory())
+	campaigns := NewCampaignRepository(store)
+
+	running := campaign.Campaign{TenantID: tenant.DefaultID, Status: campaign.StatusRunning}
+	assert.NoError(t, campaigns.Create(ctx, &running))
+
+	pending := message.Message{TenantID: tenant.DefaultID, Status: message.StatusPending, CampaignID: running.ID}
+	sent := message.Message{TenantID: tenant.DefaultID, Status: message.StatusSent, CampaignID: running.ID}
+	assert.NoError(t, repository.CreateAll(ctx, []*message.Message{&pending, &sent}))
+
+	assert.ErrorIs(t, campaigns.Cancel(ctx, tenant.DefaultID, running.ID, campaign.StatusPaused), postgresql.ErrNoRows)
+	assert.NoError(t, campaigns.Cancel(ctx, tenant.DefaultID, running.ID, campaign.StatusRunning))
+
+	progress, err := campaigns.FindProgressByID(ctx, tenant.DefaultID, running.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, campaign.Progress{message.StatusCancelled: 1, message.StatusSent: 1}, progress)
+
+	assert.NoError(t, campaigns.Delete(ctx, tenant.DefaultID, running.ID))
+
+	_, err = repository.FindByID(ctx, tenant.DefaultID, pending.ID)
+	assert.ErrorIs(t, err, postgresql.ErrNoRows)
+}
+
+func TestRepositories_OtherTenant(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repository := NewMessageRepository(store, redis.NewMemory())
+	campaigns := NewCampaignRepository(store)
+	other := "other-tenant"
+
+	running := campaign.Campaign{TenantID: tenant.DefaultID, Status: campaign.StatusRunning}
+	assert.NoError(t, campaigns.Create(ctx, &running))
+
+	pending := message.Message{TenantID: tenant.DefaultID, Status: message.StatusPending, CampaignID: running.ID}
+	assert.NoError(t, repository.Create(ctx, &pending))
+
+	assert.ErrorIs(t, repository.UpdateStatus(ctx, other, pending.ID, message.StatusPending, message.StatusSending), postgresql.ErrNoRows)
+	assert.ErrorIs(t, repository.Reschedule(ctx, other, pending.ID, message.StatusPending, time.Now()), postgresql.ErrNoRows)
+	assert.ErrorIs(t, campaigns.UpdateStatus(ctx, other, running.ID, campaign.StatusRunning, campaign.StatusPaused), postgresql.ErrNoRows)
+	assert.ErrorIs(t, campaigns.Cancel(ctx, other, running.ID, campaign.StatusRunning), postgresql.ErrNoRows)
+	assert.ErrorIs(t, campaigns.Delete(ctx, other, running.ID), postgresql.ErrNoRows)
+
+	progress, err := campaigns.FindProgressByID(ctx, other, running.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, progress)
+
+	found, err := repository.FindByID(ctx, tenant.DefaultID, pending.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, message.StatusPending, found.Status)
+
+	foundCampaign, err := campaigns.FindByID(ctx, tenant.DefaultID, running.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, campaign.StatusRunning, foundCampaign.Status)
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"messager/domain/policy"
+)
+
+type policyRepository struct {
+	store *Store
+}
+
+func NewPolicyRepository(store *Store) policy.Repository {
+	return &policyRepository{
+		store: store,
+	}
+}
+
+func (r *policyRepository) Create(ctx context.Context, policy *policy.Policy) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	policy.ID = newID()
+	policy.CreatedAt = time.Now().UTC()
+
+	r.store.policies[policy.ID] = clonePolicy(*policy)
+
+	return nil
+}
+
+func (r *policyRepository) FindAll(ctx context.Context, tenantID string) ([]policy.Policy, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var records []policy.Policy
+
+	for _, record := range r.store.policies {
+		if record.TenantID == tenantID {
+			records = append(records, clonePolicy(record))
+		}
+	}
+
+	slices.SortFunc(records, func(a, b policy.Policy) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), a.CreatedAt.Compare(b.CreatedAt))
+	})
+
+	return records, nil
+}
+
+func (r *policyRepository) Delete(ctx context.Context, tenantID, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.policies[id]
+	if !ok || record.TenantID != tenantID {
+		return errNoRows("policies")
+	}
+
+	delete(r.store.policies, id)
+
+	return nil
+}
+
+func clonePolicy(record policy.Policy) policy.Policy {
+	record.ForbiddenWords = cloneStrings(record.ForbiddenWords)
+	record.ForbiddenPatterns = cloneStrings(record.ForbiddenPatterns)
+	record.AllowedDomains = cloneStrings(record.AllowedDomains)
+
+	return record
+}
@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"messager/domain/sender"
+)
+
+type senderRepository struct {
+	store *Store
+}
+
+func NewSenderRepository(store *Store) sender.Repository {
+	return &senderRepository{
+		store: store,
+	}
+}
+
+// Create adds the sender unless its value is already in the pool of its
+// tenant, in which case no rows are reported.
+func (r *senderRepository) Create(ctx context.Context, sender *sender.Sender) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, record := range r.store.senders {
+		if record.TenantID == sender.TenantID && record.Value == sender.Value {
+			return errNoRows("senders")
+		}
+	}
+
+	sender.ID = newID()
+	sender.CreatedAt = time.Now().UTC()
+
+	record := *sender
+	record.Countries = cloneStrings(record.Countries)
+
+	r.store.senders[sender.ID] = record
+
+	return nil
+}
+
+func (r *senderRepository) FindAll(ctx context.Context, tenantID string) ([]sender.Sender, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var records []sender.Sender
+
+	for _, record := range r.store.senders {
+		if record.TenantID == tenantID {
+			record.Countries = cloneStrings(record.Countries)
+			records = append(records, record)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b sender.Sender) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.Value, b.Value))
+	})
+
+	return records, nil
+}
+
+func (r *senderRepository) Delete(ctx context.Context, tenantID, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.senders[id]
+	if !ok || record.TenantID != tenantID {
+		return errNoRows("senders")
+	}
+
+	delete(r.store.senders, id)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"messager/domain/apikey"
+	"messager/domain/campaign"
+	"messager/domain/consent"
+	"messager/domain/message"
+	"messager/domain/policy"
+	"messager/domain/sender"
+	"messager/domain/template"
+	"messager/domain/tenant"
+	"messager/infrastructure/database/postgresql"
+)
+
+// Store holds the rows of every repository in the memory of the process, for
+// running messager without PostgreSQL. Its repositories share it the way the
+// persistences share the database, so that a campaign sees its messages and a
+// claim skips the messages of paused tenants. Rows are copied in and out, and
+// missing rows are reported with postgresql.ErrNoRows as the services expect.
+type Store struct {
+	mu sync.Mutex
+
+	tenants   map[string]tenant.Tenant
+	apiKeys   map[string]apikey.APIKey
+	templates map[string]template.Template
+	campaigns map[string]campaign.Campaign
+	consents  []consent.Consent
+	policies  map[string]policy.Policy
+	senders   map[string]sender.Sender
+	messages  map[string]message.Message
+	events    []message.Event
+	attempts  []message.Attempt
+	outbox    []message.OutboxEntry
+	outboxID  int64
+
+	// relaying is held while the outbox is relayed, in place of the advisory
+	// lock, without blocking the rest of the store.
+	relaying sync.Mutex
+}
+
+// NewStore returns an empty store holding the default tenant, which the first
+// migration creates in PostgreSQL.
+func NewStore() *Store {
+	now := time.Now().UTC()
+
+	return &Store{
+		tenants: map[string]tenant.Tenant{
+			tenant.DefaultID: {
+				ID:        tenant.DefaultID,
+				CreatedAt: now,
+				UpdatedAt: now,
+				Name:      tenant.DefaultName,
+			},
+		},
+		apiKeys:   make(map[string]apikey.APIKey),
+		templates: make(map[string]template.Template),
+		campaigns: make(map[string]campaign.Campaign),
+		policies:  make(map[string]policy.Policy),
+		senders:   make(map[string]sender.Sender),
+		messages:  make(map[string]message.Message),
+	}
+}
+
+// changeStatus moves a message to a status, recording the event and the outbox
+// entry of the change the way the status changing statements do. The caller
+// holds the lock.
+func (s *Store) changeStatus(record message.Message, to message.Status, now time.Time) message.Message {
+	from := record.Status
+
+	record.Status = to
+	record.UpdatedAt = now
+	s.messages[record.ID] = record
+
+	s.addEvent(record, from, now)
+
+	return record
+}
+
+// addEvent records the event and the outbox entry of a message that moved from
+// a status to its current one, from is empty when it was created.
+func (s *Store) addEvent(record message.Message, from message.Status, now time.Time) {
+	s.events = append(s.events, message.Event{
+		ID:        newID(),
+		MessageID: record.ID,
+		CreatedAt: now,
+		From:      from,
+		To:        record.Status,
+	})
+
+	s.outboxID++
+	s.outbox = append(s.outbox, message.OutboxEntry{
+		ID:        s.outboxID,
+		MessageID: record.ID,
+		TenantID:  record.TenantID,
+		CreatedAt: now,
+		From:      from,
+		To:        record.Status,
+	})
+}
+
+// deleteMessage removes a message together with its events and attempts,
+// which reference it on delete cascade. The caller holds the lock.
+func (s *Store) deleteMessage(id string) {
+	delete(s.messages, id)
+
+	events := s.events[:0]
+	for _, event := range s.events {
+		if event.MessageID != id {
+			events = append(events, event)
+		}
+	}
+	s.events = events
+
+	attempts := s.attempts[:0]
+	for _, attempt := range s.attempts {
+		if attempt.MessageID != id {
+			attempts = append(attempts, attempt)
+		}
+	}
+	s.attempts = attempts
+}
+
+func newID() string {
+	return uuid.New().String()
+}
+
+func errNoRows(table string) error {
+	return fmt.Errorf("memory.Store.%s: %w", table, postgresql.ErrNoRows)
+}
+
+// cloneStrings copies values, an empty slice instead of nil like the array
+// columns default to.
+func cloneStrings(values []string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+
+	return slices.Clone(values)
+}
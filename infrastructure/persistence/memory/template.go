@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"messager/domain/template"
+)
+
+type templateRepository struct {
+	store *Store
+}
+
+func NewTemplateRepository(store *Store) template.Repository {
+	return &templateRepository{
+		store: store,
+	}
+}
+
+func (r *templateRepository) Create(ctx context.Context, template *template.Template) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	template.ID = newID()
+	template.CreatedAt = now
+	template.UpdatedAt = now
+
+	r.store.templates[template.ID] = cloneTemplate(*template)
+
+	return nil
+}
+
+func (r *templateRepository) FindAll(ctx context.Context, tenantID string) ([]template.Template, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var records []template.Template
+
+	for _, record := range r.store.templates {
+		if record.TenantID == tenantID {
+			records = append(records, cloneTemplate(record))
+		}
+	}
+
+	slices.SortFunc(records, func(a, b template.Template) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), a.CreatedAt.Compare(b.CreatedAt))
+	})
+
+	return records, nil
+}
+
+func (r *templateRepository) FindByID(ctx context.Context, tenantID, id string) (*template.Template, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.templates[id]
+	if !ok || record.TenantID != tenantID {
+		return nil, errNoRows("templates")
+	}
+
+	record = cloneTemplate(record)
+
+	return &record, nil
+}
+
+func (r *templateRepository) Update(ctx context.Context, template *template.Template) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.templates[template.ID]
+	if !ok || record.TenantID != template.TenantID {
+		return errNoRows("templates")
+	}
+
+	template.CreatedAt = record.CreatedAt
+	template.UpdatedAt = time.Now().UTC()
+
+	r.store.templates[template.ID] = cloneTemplate(*template)
+
+	return nil
+}
+
+func (r *templateRepository) Delete(ctx context.Context, tenantID, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.templates[id]
+	if !ok || record.TenantID != tenantID {
+		return errNoRows("templates")
+	}
+
+	delete(r.store.templates, id)
+
+	return nil
+}
+
+func cloneTemplate(record template.Template) template.Template {
+	record.Variants = slices.Clone(record.Variants)
+	if record.Variants == nil {
+		record.Variants = make([]template.Variant, 0)
+	}
+
+	return record
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"messager/domain/tenant"
+)
+
+type tenantRepository struct {
+	store *Store
+}
+
+func NewTenantRepository(store *Store) tenant.Repository {
+	return &tenantRepository{
+		store: store,
+	}
+}
+
+func (r *tenantRepository) Create(ctx context.Context, tenant *tenant.Tenant) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	tenant.ID = newID()
+	tenant.CreatedAt = now
+	tenant.UpdatedAt = now
+	tenant.Paused = false
+
+	r.store.tenants[tenant.ID] = *tenant
+
+	return nil
+}
+
+func (r *tenantRepository) FindAll(ctx context.Context) ([]tenant.Tenant, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var records []tenant.Tenant
+
+	for _, record := range r.store.tenants {
+		records = append(records, record)
+	}
+
+	slices.SortFunc(records, func(a, b tenant.Tenant) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.Name, b.Name))
+	})
+
+	return records, nil
+}
+
+func (r *tenantRepository) FindByID(ctx context.Context, id string) (*tenant.Tenant, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.tenants[id]
+	if !ok {
+		return nil, errNoRows("tenants")
+	}
+
+	return &record, nil
+}
+
+func (r *tenantRepository) Update(ctx context.Context, tenant *tenant.Tenant) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.tenants[tenant.ID]
+	if !ok {
+		return errNoRows("tenants")
+	}
+
+	record.Name = tenant.Name
+	record.DefaultRegion = tenant.DefaultRegion
+	record.DailyQuota = tenant.DailyQuota
+	record.UpdatedAt = time.Now().UTC()
+
+	r.store.tenants[record.ID] = record
+
+	tenant.CreatedAt = record.CreatedAt
+	tenant.UpdatedAt = record.UpdatedAt
+	tenant.Paused = record.Paused
+
+	return nil
+}
+
+func (r *tenantRepository) UpdatePaused(ctx context.Context, id string, paused bool) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.tenants[id]
+	if !ok {
+		return errNoRows("tenants")
+	}
+
+	record.Paused = paused
+	record.UpdatedAt = time.Now().UTC()
+
+	r.store.tenants[id] = record
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package message
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"messager/domain/message"
+	"messager/infrastructure/database/redis"
+)
+
+type attemptRecord struct {
+	ID                string    `json:"id"`
+	MessageID         string    `json:"messageId"`
+	CreatedAt         time.Time `json:"createdAt"`
+	Provider          string    `json:"provider"`
+	ProviderMessageID string    `json:"providerMessageId,omitempty"`
+	Status            string    `json:"status"`
+	ResponseStatus    int       `json:"responseStatus,omitempty"`
+	LatencyMS         int64     `json:"latencyMs"`
+	ErrorClass        string    `json:"errorClass,omitempty"`
+}
+
+// attemptKey is the key of the attempts of a message cached at version. The
+// version is bumped whenever an attempt is recorded, so that a list read
+// before it can only be cached under a key that is no longer read.
+func attemptKey(tenantID, messageID string, version int64) string {
+	return fmt.Sprintf("attempts:message:%s:%s:%d", tenantID, messageID, version)
+}
+
+func attemptVersionKey(tenantID, messageID string) string {
+	return fmt.Sprintf("attempts:message:%s:%s:version", tenantID, messageID)
+}
+
+func (p *persistence) cachesAttempts() bool {
+	return p.redis != nil && p.config.AttemptCacheTTL > 0
+}
+
+// attemptVersion returns the version the attempts of a message are cached at,
+// zero before any is recorded.
+func (p *persistence) attemptVersion(ctx context.Context, tenantID, messageID string) (int64, error) {
+	value, err := p.redis.Get(ctx, attemptVersionKey(tenantID, messageID))
+	if errors.Is(err, redis.ErrNil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("persistence.redis.Get(): %w", err)
+	}
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("strconv.ParseInt(): %w", err)
+	}
+
+	return version, nil
+}
+
+// bumpAttemptVersion moves the attempts of a message to a new version. The
+// version outlives the lists cached at the previous one, so that it is not
+// reset while they can still be read.
+func (p *persistence) bumpAttemptVersion(ctx context.Context, tenantID, messageID string) error {
+	key := attemptVersionKey(tenantID, messageID)
+
+	if _, err := p.redis.IncrBy(ctx, key, 1); err != nil {
+		return fmt.Errorf("persistence.redis.IncrBy(): %w", err)
+	}
+
+	if err := p.redis.Expire(ctx, key, 2*p.config.AttemptCacheTTL); err != nil {
+		return fmt.Errorf("persistence.redis.Expire(): %w", err)
+	}
+
+	return nil
+}
+
+// cacheError reports an error of the attempt cache, which is only a cache and
+// never fails the operation.
+func (p *persistence) cacheError(err error) {
+	if p.config.OnCacheError != nil {
+		p.config.OnCacheError(err)
+	}
+}
+
+func marshalAttempts(attempts []message.Attempt) (string, error) {
+	records := make([]attemptRecord, 0, len(attempts))
+
+	for _, attempt := range attempts {
+		records = append(records, attemptRecord{
+			ID:                attempt.ID,
+			MessageID:         attempt.MessageID,
+			CreatedAt:         attempt.CreatedAt,
+			Provider:          attempt.Provider,
+			ProviderMessageID: attempt.ProviderMessageID,
+			Status:            string(attempt.Status),
+			ResponseStatus:    attempt.ResponseStatus,
+			LatencyMS:         attempt.Latency.Milliseconds(),
+			ErrorClass:        string(attempt.ErrorClass),
+		})
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	return string(data), nil
+}
+
+func unmarshalAttempts(value string) ([]message.Attempt, error) {
+	var records []attemptRecord
+
+	if err := json.Unmarshal([]byte(value), &records); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	attempts := make([]message.Attempt, 0, len(records))
+
+	for _, record := range records {
+		attempts = append(attempts, message.Attempt{
+			ID:                record.ID,
+			MessageID:         record.MessageID,
+			CreatedAt:         record.CreatedAt,
+			Provider:          record.Provider,
+			ProviderMessageID: record.ProviderMessageID,
+			Status:            message.AttemptStatus(record.Status),
+			ResponseStatus:    record.ResponseStatus,
+			Latency:           time.Duration(record.LatencyMS) * time.Millisecond,
+			ErrorClass:        message.ErrorClass(record.ErrorClass),
+		})
+	}
+
+	return attempts, nil
+}
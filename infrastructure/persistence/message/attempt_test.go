@@ -0,0 +1,176 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"messager/domain/message"
+	"messager/infrastructure/database/postgresql"
+	"messager/infrastructure/database/redis"
+)
+
+const (
+	testTenantID  = "4b7a1c8e-2f5d-4c3a-9e6b-1d2f3a4b5c6d"
+	testMessageID = "9f8e7d6c-5b4a-4c3d-8e2f-1a0b9c8d7e6f"
+)
+
+type fakePostgreSQL struct {
+	postgresql.PostgreSQL
+	attempts []message.Attempt
+	queries  int
+}
+
+func (f *fakePostgreSQL) Query(ctx context.Context, query string, arguments ...any) (postgresql.Rows, error) {
+	f.queries++
+
+	return &fakeRows{attempts: append([]message.Attempt(nil), f.attempts...), index: -1}, nil
+}
+
+func (f *fakePostgreSQL) QueryRow(ctx context.Context, query string, arguments ...any) postgresql.Row {
+	return fakeRow(func(destination ...any) error {
+		attempt := message.Attempt{ID: "attempt", MessageID: arguments[0].(string), CreatedAt: time.Now()}
+		f.attempts = append(f.attempts, attempt)
+
+		*destination[0].(*string) = attempt.ID
+		*destination[1].(*time.Time) = attempt.CreatedAt
+		*destination[2].(*string) = testTenantID
+
+		return nil
+	})
+}
+
+type fakeRows struct {
+	attempts []message.Attempt
+	index    int
+}
+
+func (r *fakeRows) Close() {}
+
+func (r *fakeRows) Next() bool {
+	r.index++
+
+	return r.index < len(r.attempts)
+}
+
+func (r *fakeRows) Scan(destination ...any) error {
+	attempt := r.attempts[r.index]
+
+	*destination[0].(*string) = attempt.ID
+	*destination[1].(*string) = attempt.MessageID
+	*destination[2].(*time.Time) = attempt.CreatedAt
+
+	return nil
+}
+
+func (r *fakeRows) Err() error {
+	return nil
+}
+
+type fakeRow func(destination ...any) error
+
+func (r fakeRow) Scan(destination ...any) error {
+	return r(destination...)
+}
+
+type failingRedis struct {
+	redis.Redis
+	failing bool
+}
+
+func (r *failingRedis) Get(ctx context.Context, key string) (string, error) {
+	if r.failing {
+		return "", errors.New("connection refused")
+	}
+
+	return r.Redis.Get(ctx, key)
+}
+
+func (r *failingRedis) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	if r.failing {
+		return errors.New("connection refused")
+	}
+
+	return r.Redis.Set(ctx, key, value, ttl)
+}
+
+func (r *failingRedis) IncrBy(ctx context.Context, key string, value int64) (int64, error) {
+	if r.failing {
+		return 0, errors.New("connection refused")
+	}
+
+	return r.Redis.IncrBy(ctx, key, value)
+}
+
+func TestPersistence_Attempts(t *testing.T) {
+	ctx := context.Background()
+
+	newPersistence := func() (*persistence, *fakePostgreSQL, *failingRedis, *[]error) {
+		postgreSQL := &fakePostgreSQL{}
+		cache := &failingRedis{Redis: redis.NewMemory()}
+		errs := new([]error)
+
+		p := New(postgreSQL, cache, Config{
+			AttemptCacheTTL: time.Minute,
+			OnCacheError: func(err error) {
+				*errs = append(*errs, err)
+			},
+		}).(*persistence)
+
+		return p, postgreSQL, cache, errs
+	}
+
+	t.Run("caches attempts until one is recorded", func(t *testing.T) {
+		p, postgreSQL, _, errs := newPersistence()
+
+		attempts, err := p.FindAllAttemptsByMessageID(ctx, testTenantID, testMessageID)
+		require.NoError(t, err)
+		assert.Empty(t, attempts)
+
+		_, err = p.FindAllAttemptsByMessageID(ctx, testTenantID, testMessageID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, postgreSQL.queries)
+
+		require.NoError(t, p.CreateAttempt(ctx, &message.Attempt{MessageID: testMessageID}))
+
+		attempts, err = p.FindAllAttemptsByMessageID(ctx, testTenantID, testMessageID)
+		require.NoError(t, err)
+		assert.Len(t, attempts, 1)
+		assert.Equal(t, 2, postgreSQL.queries)
+		assert.Empty(t, *errs)
+	})
+
+	t.Run("a read racing with a recorded attempt is not served", func(t *testing.T) {
+		p, postgreSQL, _, _ := newPersistence()
+
+		// A read that started before the attempt was recorded caches the
+		// list without it once the attempt is recorded.
+		version, err := p.attemptVersion(ctx, testTenantID, testMessageID)
+		require.NoError(t, err)
+
+		require.NoError(t, p.CreateAttempt(ctx, &message.Attempt{MessageID: testMessageID}))
+		require.NoError(t, p.cacheAttempts(ctx, attemptKey(testTenantID, testMessageID, version), nil))
+
+		attempts, err := p.FindAllAttemptsByMessageID(ctx, testTenantID, testMessageID)
+		require.NoError(t, err)
+		assert.Len(t, attempts, 1)
+		assert.Equal(t, 1, postgreSQL.queries)
+	})
+
+	t.Run("cache errors are misses", func(t *testing.T) {
+		p, postgreSQL, cache, errs := newPersistence()
+		cache.failing = true
+
+		require.NoError(t, p.CreateAttempt(ctx, &message.Attempt{MessageID: testMessageID}))
+
+		attempts, err := p.FindAllAttemptsByMessageID(ctx, testTenantID, testMessageID)
+		require.NoError(t, err)
+		assert.Len(t, attempts, 1)
+		assert.Equal(t, 1, postgreSQL.queries)
+		assert.Len(t, *errs, 2)
+	})
+}
@@ -0,0 +1,63 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+)
+
+// ClaimAllByStatusAndPriority compares send_at and valid_until, which hold UTC
+// without a time zone, with the current UTC time rather than now(), which
+// would be converted to the time zone of the session.
+func (p *persistence) ClaimAllByStatusAndPriority(ctx context.Context, from, to message.Status, priority message.Priority, limit int) ([]message.Message, error) {
+	query := `
+		WITH claimed AS (
+			UPDATE messages
+			SET status = $1, updated_at = now()
+			WHERE id IN (
+				SELECT id
+				FROM messages
+				WHERE status = $2 AND priority = $4 AND send_at <= $5 AND (valid_until IS NULL OR valid_until > $5)
+					AND (campaign_id IS NULL OR campaign_id IN (SELECT id FROM campaigns WHERE status = 'RUNNING'))
+					AND tenant_id NOT IN (SELECT id FROM tenants WHERE paused)
+				ORDER BY send_at, created_at
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + columns + `
+		), events AS (
+			INSERT INTO message_events (message_id, from_status, to_status)
+			SELECT id, $2, status FROM claimed
+		), outbox AS (
+			INSERT INTO message_outbox (message_id, tenant_id, from_status, to_status)
+			SELECT id, tenant_id, $2, status FROM claimed ORDER BY send_at, created_at
+		)
+		SELECT ` + columns + ` FROM claimed;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, to, from, limit, priority, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []message.Message
+
+	for rows.Next() {
+		var record message.Message
+
+		if err := scan(rows, &record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,22 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+)
+
+func (p *persistence) CompleteIdempotencyKey(ctx context.Context, record message.IdempotencyRecord, ttl time.Duration) error {
+	value, err := marshalIdempotencyRecord(record)
+	if err != nil {
+		return err
+	}
+
+	if err := p.redis.Set(ctx, idempotencyKey(record.TenantID, record.Key), value, ttl); err != nil {
+		return fmt.Errorf("persistence.redis.Set(): %w", err)
+	}
+
+	return nil
+}
@@ -9,13 +9,30 @@ import (
 
 func (p *persistence) Create(ctx context.Context, message *message.Message) error {
 	query := `
-		INSERT INTO messages (content, phone, status)
-		VALUES ($1, $2, $3)
-		RETURNING id, created_at, updated_at;
+		WITH created AS (
+			INSERT INTO messages (
+				content, phone, status, send_at, valid_until, priority, encoding, segments,
+				country_code, region, template_id, locale, campaign_id, category, channel, email, subject, html_content,
+				sender, metadata, tags, tenant_id
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
+			RETURNING id, tenant_id, created_at, updated_at, status
+		), events AS (
+			INSERT INTO message_events (message_id, to_status)
+			SELECT id, status FROM created
+		), outbox AS (
+			INSERT INTO message_outbox (message_id, tenant_id, to_status)
+			SELECT id, tenant_id, status FROM created
+		)
+		SELECT id, created_at, updated_at FROM created;
 	`
 
 	row := p.postgreSQL.QueryRow(ctx, query,
-		message.Content, message.Phone, message.Status)
+		message.Content, message.Phone, message.Status, message.SendAt, nullableTime(message.ValidUntil),
+		message.Priority, message.Encoding, message.Segments,
+		message.CountryCode, message.Region, nullableString(message.TemplateID), message.Locale,
+		nullableString(message.CampaignID), message.Category, message.Channel, message.Email, message.Subject,
+		message.HTMLContent, message.Sender, nonNilMetadata(message.Metadata), nonNilTags(message.Tags), message.TenantID)
 
 	if err := row.Scan(&message.ID, &message.CreatedAt, &message.UpdatedAt); err != nil {
 		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
@@ -0,0 +1,135 @@
+package message
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"messager/domain/message"
+)
+
+// CreateAll inserts the messages and their events in a single statement by
+// unnesting one array per column.
+func (p *persistence) CreateAll(ctx context.Context, messages []*message.Message) error {
+	var (
+		ids          = make([]string, len(messages))
+		contents     = make([]string, len(messages))
+		phones       = make([]string, len(messages))
+		statuses     = make([]string, len(messages))
+		sendAts      = make([]time.Time, len(messages))
+		validUntils  = make([]*time.Time, len(messages))
+		priorities   = make([]string, len(messages))
+		encodings    = make([]string, len(messages))
+		segments     = make([]int, len(messages))
+		countryCodes = make([]int, len(messages))
+		regions      = make([]string, len(messages))
+		templateIDs  = make([]*string, len(messages))
+		locales      = make([]string, len(messages))
+		campaignIDs  = make([]*string, len(messages))
+		categories   = make([]string, len(messages))
+		channels     = make([]string, len(messages))
+		emails       = make([]string, len(messages))
+		subjects     = make([]string, len(messages))
+		htmlContents = make([]string, len(messages))
+		senders      = make([]string, len(messages))
+		metadata     = make([]string, len(messages))
+		tags         = make([]string, len(messages))
+		tenantIDs    = make([]string, len(messages))
+		byID         = make(map[string]*message.Message, len(messages))
+	)
+
+	for i, message := range messages {
+		ids[i] = uuid.New().String()
+		contents[i] = message.Content
+		phones[i] = message.Phone
+		statuses[i] = string(message.Status)
+		sendAts[i] = message.SendAt
+		validUntils[i] = nullableTime(message.ValidUntil)
+		priorities[i] = string(message.Priority)
+		encodings[i] = string(message.Encoding)
+		segments[i] = message.Segments
+		countryCodes[i] = message.CountryCode
+		regions[i] = message.Region
+		templateIDs[i] = nullableString(message.TemplateID)
+		locales[i] = message.Locale
+		campaignIDs[i] = nullableString(message.CampaignID)
+		categories[i] = string(message.Category)
+		channels[i] = string(message.Channel)
+		emails[i] = message.Email
+		subjects[i] = message.Subject
+		htmlContents[i] = message.HTMLContent
+		senders[i] = message.Sender
+		metadata[i] = marshal(nonNilMetadata(message.Metadata))
+		tags[i] = marshal(nonNilTags(message.Tags))
+		tenantIDs[i] = message.TenantID
+		byID[ids[i]] = message
+	}
+
+	query := `
+		WITH created AS (
+			INSERT INTO messages (
+				id, content, phone, status, send_at, valid_until, priority, encoding, segments,
+				country_code, region, template_id, locale, campaign_id, category, channel, email, subject, html_content,
+				sender, metadata, tags, tenant_id
+			)
+			SELECT * FROM unnest(
+				$1::uuid[], $2::text[], $3::varchar[], $4::message_status[], $5::timestamp[], $6::timestamp[],
+				$7::message_priority[], $8::varchar[], $9::integer[], $10::integer[], $11::varchar[], $12::uuid[],
+				$13::varchar[], $14::uuid[], $15::message_category[], $16::message_channel[], $17::varchar[],
+				$18::varchar[], $19::text[], $20::varchar[], $21::jsonb[], $22::jsonb[], $23::uuid[]
+			)
+			RETURNING id, tenant_id, created_at, updated_at, status
+		), events AS (
+			INSERT INTO message_events (message_id, to_status)
+			SELECT id, status FROM created
+		), outbox AS (
+			INSERT INTO message_outbox (message_id, tenant_id, to_status)
+			SELECT id, tenant_id, status FROM created
+		)
+		SELECT id, created_at, updated_at FROM created;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query,
+		ids, contents, phones, statuses, sendAts, validUntils,
+		priorities, encodings, segments, countryCodes, regions, templateIDs,
+		locales, campaignIDs, categories, channels, emails, subjects, htmlContents, senders, metadata, tags,
+		tenantIDs)
+	if err != nil {
+		return fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id                   string
+			createdAt, updatedAt time.Time
+		)
+
+		if err := rows.Scan(&id, &createdAt, &updatedAt); err != nil {
+			return fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		if message, ok := byID[id]; ok {
+			message.ID = id
+			message.CreatedAt = createdAt
+			message.UpdatedAt = updatedAt
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return nil
+}
+
+// marshal encodes a value as JSON text, which the driver passes to a JSONB
+// array as is.
+func marshal(value any) string {
+	data, _ := json.Marshal(value)
+
+	return string(data)
+}
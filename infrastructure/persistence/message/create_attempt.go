@@ -0,0 +1,41 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/message"
+)
+
+// CreateAttempt records a delivery attempt and bumps the cache version of the
+// attempts of its message, so that they are read again on the next lookup.
+// The attempt is recorded even when the cache cannot be bumped; the error is
+// reported and the cached attempts are stale until they expire.
+func (p *persistence) CreateAttempt(ctx context.Context, attempt *message.Attempt) error {
+	query := `
+		INSERT INTO delivery_attempts (
+			message_id, provider, provider_message_id, status, response_status, latency_ms, error_class
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, (SELECT tenant_id FROM messages WHERE id = $1);
+	`
+	row := p.postgreSQL.QueryRow(ctx, query,
+		attempt.MessageID, attempt.Provider, attempt.ProviderMessageID, attempt.Status, attempt.ResponseStatus,
+		attempt.Latency.Milliseconds(), attempt.ErrorClass)
+
+	var tenantID string
+
+	if err := row.Scan(&attempt.ID, &attempt.CreatedAt, &tenantID); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	if !p.cachesAttempts() {
+		return nil
+	}
+
+	if err := p.bumpAttemptVersion(ctx, tenantID, attempt.MessageID); err != nil {
+		p.cacheError(err)
+	}
+
+	return nil
+}
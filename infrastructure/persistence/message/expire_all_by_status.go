@@ -0,0 +1,36 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+)
+
+func (p *persistence) ExpireAllByStatus(ctx context.Context, status message.Status) error {
+	query := `
+		WITH expired AS (
+			UPDATE messages
+			SET status = $1, updated_at = now()
+			WHERE id IN (
+				SELECT id
+				FROM messages
+				WHERE status = $2 AND valid_until <= $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, tenant_id, status
+		), events AS (
+			INSERT INTO message_events (message_id, from_status, to_status)
+			SELECT id, $2, status FROM expired
+		)
+		INSERT INTO message_outbox (message_id, tenant_id, from_status, to_status)
+		SELECT id, tenant_id, $2, status FROM expired;
+	`
+
+	if err := p.postgreSQL.Exec(ctx, query, message.StatusExpired, status, time.Now().UTC()); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.Exec(): %w", err)
+	}
+
+	return nil
+}
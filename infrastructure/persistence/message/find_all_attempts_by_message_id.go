@@ -0,0 +1,112 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+	"messager/infrastructure/database/redis"
+)
+
+// FindAllAttemptsByMessageID returns the delivery attempts of a message in
+// the order they were made, from the cache when it holds them. Errors of the
+// cache are reported and read from PostgreSQL instead.
+func (p *persistence) FindAllAttemptsByMessageID(ctx context.Context, tenantID, messageID string) ([]message.Attempt, error) {
+	caches := p.cachesAttempts()
+
+	var version int64
+
+	if caches {
+		var err error
+
+		version, err = p.attemptVersion(ctx, tenantID, messageID)
+		if err != nil {
+			p.cacheError(err)
+			caches = false
+		}
+	}
+
+	if caches {
+		attempts, err := p.findCachedAttempts(ctx, attemptKey(tenantID, messageID, version))
+		if err == nil {
+			return attempts, nil
+		}
+		if !errors.Is(err, redis.ErrNil) {
+			p.cacheError(err)
+		}
+	}
+
+	query := `
+		SELECT delivery_attempts.id, message_id, delivery_attempts.created_at, provider, provider_message_id,
+			delivery_attempts.status, response_status, latency_ms, error_class
+		FROM delivery_attempts
+		JOIN messages ON messages.id = delivery_attempts.message_id
+		WHERE messages.tenant_id = $1 AND message_id = $2
+		ORDER BY delivery_attempts.created_at;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	records := make([]message.Attempt, 0)
+
+	for rows.Next() {
+		var (
+			record    message.Attempt
+			latencyMS int64
+		)
+
+		if err := rows.Scan(
+			&record.ID, &record.MessageID, &record.CreatedAt, &record.Provider, &record.ProviderMessageID,
+			&record.Status, &record.ResponseStatus, &latencyMS, &record.ErrorClass,
+		); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		record.Latency = time.Duration(latencyMS) * time.Millisecond
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	if caches {
+		if err := p.cacheAttempts(ctx, attemptKey(tenantID, messageID, version), records); err != nil {
+			p.cacheError(err)
+		}
+	}
+
+	return records, nil
+}
+
+func (p *persistence) findCachedAttempts(ctx context.Context, key string) ([]message.Attempt, error) {
+	value, err := p.redis.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, redis.ErrNil) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("persistence.redis.Get(): %w", err)
+	}
+
+	return unmarshalAttempts(value)
+}
+
+func (p *persistence) cacheAttempts(ctx context.Context, key string, attempts []message.Attempt) error {
+	value, err := marshalAttempts(attempts)
+	if err != nil {
+		return err
+	}
+
+	if err := p.redis.Set(ctx, key, value, p.config.AttemptCacheTTL); err != nil {
+		return fmt.Errorf("persistence.redis.Set(): %w", err)
+	}
+
+	return nil
+}
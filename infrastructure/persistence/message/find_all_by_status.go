@@ -3,39 +3,120 @@ package message
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"messager/domain/message"
 )
 
-func (p *persistence) FindAllByStatus(ctx context.Context, status message.Status) ([]message.Message, error) {
+// FindAllByStatus returns a page of the messages of a tenant narrowed by the
+// filter, newest first. Only the given filters are added to the query so that
+// the indexes on status, phone, tags, metadata and content can be used. Pages
+// are read by keyset on created_at and id, one more row than the limit is
+// fetched to tell whether there is a next page.
+func (p *persistence) FindAllByStatus(ctx context.Context, filter message.ListFilter) (*message.Page, error) {
+	conditions := "tenant_id = $1"
+	arguments := []any{filter.TenantID}
+
+	add := func(condition string, argument any) {
+		arguments = append(arguments, argument)
+		conditions += " AND " + condition + " $" + strconv.Itoa(len(arguments))
+	}
+
+	if len(filter.Statuses) == 1 {
+		add("status =", filter.Statuses[0])
+	} else if len(filter.Statuses) > 1 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+
+		arguments = append(arguments, statuses)
+		conditions += " AND status = ANY($" + strconv.Itoa(len(arguments)) + "::message_status[])"
+	}
+
+	if filter.Phone != "" {
+		add("phone =", filter.Phone)
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		add("created_at >=", filter.CreatedFrom.UTC())
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		add("created_at <", filter.CreatedTo.UTC())
+	}
+
+	if !filter.UpdatedFrom.IsZero() {
+		add("updated_at >=", filter.UpdatedFrom.UTC())
+	}
+
+	if !filter.UpdatedTo.IsZero() {
+		add("updated_at <", filter.UpdatedTo.UTC())
+	}
+
+	if filter.Query != "" {
+		arguments = append(arguments, filter.Query)
+		conditions += " AND to_tsvector('simple', content) @@ websearch_to_tsquery('simple', $" + strconv.Itoa(len(arguments)) + ")"
+	}
+
+	if filter.Tag != "" {
+		add("tags @>", []string{filter.Tag})
+	}
+
+	if filter.MetadataKey != "" && filter.MetadataValue != "" {
+		add("metadata @>", map[string]string{filter.MetadataKey: filter.MetadataValue})
+	} else if filter.MetadataKey != "" {
+		add("metadata ?", filter.MetadataKey)
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := message.ParseCursor(filter.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("message.ParseCursor(): %w", err)
+		}
+
+		arguments = append(arguments, cursor.CreatedAt.UTC(), cursor.ID)
+		conditions += " AND (created_at, id) < ($" + strconv.Itoa(len(arguments)-1) + ", $" + strconv.Itoa(len(arguments)) + ")"
+	}
+
+	arguments = append(arguments, filter.Limit+1)
+
 	query := `
-		SELECT id, created_at, updated_at, content, phone, status
+		SELECT ` + columns + `
 		FROM messages
-		WHERE status = $1
-		ORDER BY created_at DESC;
+		WHERE ` + conditions + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $` + strconv.Itoa(len(arguments)) + `;
 	`
-	rows, err := p.postgreSQL.Query(ctx, query, status)
+	rows, err := p.postgreSQL.Query(ctx, query, arguments...)
 	if err != nil {
 		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
 	}
 
 	defer rows.Close()
 
-	var records []message.Message
+	page := message.Page{
+		Items: make([]message.Message, 0, filter.Limit),
+	}
 
 	for rows.Next() {
 		var record message.Message
 
-		if err := rows.Scan(&record.ID, &record.CreatedAt, &record.UpdatedAt, &record.Content, &record.Phone, &record.Status); err != nil {
+		if err := scan(rows, &record); err != nil {
 			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
 		}
 
-		records = append(records, record)
+		page.Items = append(page.Items, record)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
 	}
 
-	return records, nil
+	if len(page.Items) > filter.Limit {
+		page.Items = page.Items[:filter.Limit]
+		page.NextCursor = message.NewCursor(page.Items[filter.Limit-1])
+	}
+
+	return &page, nil
 }
@@ -0,0 +1,42 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/message"
+)
+
+func (p *persistence) FindAllEventsByMessageID(ctx context.Context, tenantID, messageID string) ([]message.Event, error) {
+	query := `
+		SELECT message_events.id, message_id, message_events.created_at, COALESCE(from_status::TEXT, ''), to_status
+		FROM message_events
+		JOIN messages ON messages.id = message_events.message_id
+		WHERE messages.tenant_id = $1 AND message_id = $2
+		ORDER BY message_events.created_at;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []message.Event
+
+	for rows.Next() {
+		var record message.Event
+
+		if err := rows.Scan(&record.ID, &record.MessageID, &record.CreatedAt, &record.From, &record.To); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
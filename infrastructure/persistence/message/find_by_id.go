@@ -7,17 +7,17 @@ import (
 	"messager/domain/message"
 )
 
-func (p *persistence) FindByID(ctx context.Context, id string) (*message.Message, error) {
+func (p *persistence) FindByID(ctx context.Context, tenantID, id string) (*message.Message, error) {
 	query := `
-		SELECT id, created_at, updated_at, content, phone, status
+		SELECT ` + columns + `
 		FROM messages
-		WHERE id = $1
+		WHERE tenant_id = $1 AND id = $2
 	`
-	row := p.postgreSQL.QueryRow(ctx, query, id)
+	row := p.postgreSQL.QueryRow(ctx, query, tenantID, id)
 
 	var record message.Message
 
-	if err := row.Scan(&record.ID, &record.CreatedAt, &record.UpdatedAt, &record.Content, &record.Phone, &record.Status); err != nil {
+	if err := scan(row, &record); err != nil {
 		return nil, fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
 	}
 
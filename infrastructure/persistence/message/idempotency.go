@@ -0,0 +1,44 @@
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"messager/domain/message"
+)
+
+type idempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	MessageID   string `json:"messageId,omitempty"`
+}
+
+func idempotencyKey(tenantID, key string) string {
+	return fmt.Sprintf("idempotency:message:%s:%s", tenantID, key)
+}
+
+func marshalIdempotencyRecord(record message.IdempotencyRecord) (string, error) {
+	data, err := json.Marshal(idempotencyRecord{
+		Fingerprint: record.Fingerprint,
+		MessageID:   record.MessageID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	return string(data), nil
+}
+
+func unmarshalIdempotencyRecord(tenantID, key, value string) (*message.IdempotencyRecord, error) {
+	var record idempotencyRecord
+
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &message.IdempotencyRecord{
+		TenantID:    tenantID,
+		Key:         key,
+		Fingerprint: record.Fingerprint,
+		MessageID:   record.MessageID,
+	}, nil
+}
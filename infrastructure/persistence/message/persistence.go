@@ -1,28 +1,34 @@
 package message
 
 import (
-	"context"
-	"fmt"
+	"time"
 
 	"messager/domain/message"
 	"messager/infrastructure/database/postgresql"
 	"messager/infrastructure/database/redis"
 )
 
+type Config struct {
+	// AttemptCacheTTL is how long delivery attempts are cached in Redis,
+	// they are not cached when it is zero.
+	AttemptCacheTTL time.Duration
+	// OnCacheError is called with the errors of the attempt cache, which are
+	// otherwise handled as cache misses.
+	OnCacheError func(err error)
+}
+
 type persistence struct {
 	postgreSQL postgresql.PostgreSQL
 	redis      redis.Redis
+	config     *Config
 }
 
-func New(postgreSQL postgresql.PostgreSQL, redis redis.Redis) (message.Repository, error) {
+func New(postgreSQL postgresql.PostgreSQL, redis redis.Redis, config Config) message.Repository {
 	p := persistence{
 		postgreSQL: postgreSQL,
 		redis:      redis,
+		config:     &config,
 	}
 
-	if err := p.migrate(context.Background()); err != nil {
-		return nil, fmt.Errorf("persistence.migrate(): %w", err)
-	}
-
-	return &p, nil
+	return &p
 }
@@ -0,0 +1,14 @@
+package message
+
+import (
+	"fmt"
+	"time"
+)
+
+// quotaTTL keeps the counter of a day until the day is over in every time
+// zone.
+const quotaTTL = 48 * time.Hour
+
+func quotaKey(tenantID string, day time.Time) string {
+	return fmt.Sprintf("quota:tenant:%s:%s", tenantID, day.UTC().Format(time.DateOnly))
+}
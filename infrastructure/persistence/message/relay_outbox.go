@@ -0,0 +1,78 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/message"
+	"messager/infrastructure/database/postgresql"
+)
+
+// outboxLockID is the advisory lock held while relaying, so that only one
+// replica relays at a time and the entries of a message stay in order.
+const outboxLockID = 4_137_218_210
+
+// RelayOutbox hands the oldest entries of the outbox to relay and deletes them
+// once it succeeds, in a transaction holding the outbox lock. The entries are
+// kept for the next run when relay fails or another replica holds the lock.
+func (p *persistence) RelayOutbox(ctx context.Context, limit int, relay func(entries []message.OutboxEntry) error) error {
+	return p.postgreSQL.Transaction(ctx, func(tx postgresql.Tx) error {
+		var locked bool
+
+		if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1);`, outboxLockID).Scan(&locked); err != nil {
+			return fmt.Errorf("tx.QueryRow().Row.Scan(): %w", err)
+		}
+
+		if !locked {
+			return nil
+		}
+
+		query := `
+			SELECT id, message_id, tenant_id, created_at, COALESCE(from_status::TEXT, ''), to_status
+			FROM message_outbox
+			ORDER BY id
+			LIMIT $1;
+		`
+		rows, err := tx.Query(ctx, query, limit)
+		if err != nil {
+			return fmt.Errorf("tx.Query(): %w", err)
+		}
+
+		var (
+			entries []message.OutboxEntry
+			ids     []int64
+		)
+
+		for rows.Next() {
+			var entry message.OutboxEntry
+
+			if err := rows.Scan(&entry.ID, &entry.MessageID, &entry.TenantID, &entry.CreatedAt, &entry.From, &entry.To); err != nil {
+				rows.Close()
+				return fmt.Errorf("tx.Query().Rows.Scan(): %w", err)
+			}
+
+			entries = append(entries, entry)
+			ids = append(ids, entry.ID)
+		}
+
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("tx.Query().Rows.Err(): %w", err)
+		}
+
+		if len(entries) == 0 {
+			return nil
+		}
+
+		if err := relay(entries); err != nil {
+			return err
+		}
+
+		if err := tx.Exec(ctx, `DELETE FROM message_outbox WHERE id = ANY($1);`, ids); err != nil {
+			return fmt.Errorf("tx.Exec(): %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,14 @@
+package message
+
+import (
+	"context"
+	"fmt"
+)
+
+func (p *persistence) ReleaseIdempotencyKey(ctx context.Context, tenantID, key string) error {
+	if err := p.redis.Del(ctx, idempotencyKey(tenantID, key)); err != nil {
+		return fmt.Errorf("persistence.redis.Del(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ReleaseQuota gives back count messages reserved from the daily quota of a
+// tenant that were not created after all.
+func (p *persistence) ReleaseQuota(ctx context.Context, tenantID string, day time.Time, count int) error {
+	if _, err := p.redis.IncrBy(ctx, quotaKey(tenantID, day), -int64(count)); err != nil {
+		return fmt.Errorf("persistence.redis.IncrBy(): %w", err)
+	}
+
+	return nil
+}
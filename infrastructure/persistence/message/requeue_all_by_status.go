@@ -0,0 +1,38 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+)
+
+// RequeueAllByStatus moves the messages that have been in status since before
+// updatedBefore back to PENDING, so that they are claimed again.
+func (p *persistence) RequeueAllByStatus(ctx context.Context, status message.Status, updatedBefore time.Time) error {
+	query := `
+		WITH requeued AS (
+			UPDATE messages
+			SET status = $1, updated_at = now()
+			WHERE id IN (
+				SELECT id
+				FROM messages
+				WHERE status = $2 AND updated_at < $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, tenant_id, status
+		), events AS (
+			INSERT INTO message_events (message_id, from_status, to_status)
+			SELECT id, $2, status FROM requeued
+		)
+		INSERT INTO message_outbox (message_id, tenant_id, from_status, to_status)
+		SELECT id, tenant_id, $2, status FROM requeued;
+	`
+
+	if err := p.postgreSQL.Exec(ctx, query, message.StatusPending, status, updatedBefore.UTC()); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.Exec(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+)
+
+// Reschedule holds a claimed message in QUEUED until send at, when it is
+// claimed again.
+func (p *persistence) Reschedule(ctx context.Context, tenantID, id string, from message.Status, sendAt time.Time) error {
+	query := `
+		WITH updated AS (
+			UPDATE messages
+			SET status = $1, send_at = $2, updated_at = now()
+			WHERE tenant_id = $3 AND id = $4 AND status = $5
+			RETURNING id, tenant_id, status
+		), events AS (
+			INSERT INTO message_events (message_id, from_status, to_status)
+			SELECT id, $5, status FROM updated
+		), outbox AS (
+			INSERT INTO message_outbox (message_id, tenant_id, from_status, to_status)
+			SELECT id, tenant_id, $5, status FROM updated
+		)
+		SELECT id FROM updated;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, message.StatusQueued, sendAt.UTC(), tenantID, id, from)
+
+	if err := row.Scan(&id); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
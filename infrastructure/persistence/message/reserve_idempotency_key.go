@@ -0,0 +1,42 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+	"messager/infrastructure/database/redis"
+)
+
+// ReserveIdempotencyKey stores the record unless its key is already taken, in
+// which case the stored record is returned instead.
+func (p *persistence) ReserveIdempotencyKey(ctx context.Context, record message.IdempotencyRecord, ttl time.Duration) (*message.IdempotencyRecord, error) {
+	value, err := marshalIdempotencyRecord(record)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		reserved, err := p.redis.SetNX(ctx, idempotencyKey(record.TenantID, record.Key), value, ttl)
+		if err != nil {
+			return nil, fmt.Errorf("persistence.redis.SetNX(): %w", err)
+		}
+
+		if reserved {
+			return nil, nil
+		}
+
+		storedValue, err := p.redis.Get(ctx, idempotencyKey(record.TenantID, record.Key))
+		if errors.Is(err, redis.ErrNil) {
+			// The key expired or was released in between, try to reserve it again.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("persistence.redis.Get(): %w", err)
+		}
+
+		return unmarshalIdempotencyRecord(record.TenantID, record.Key, storedValue)
+	}
+}
@@ -0,0 +1,32 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ReserveQuota counts count messages against the daily quota of a tenant. It
+// reports false and counts nothing when the messages would exceed limit.
+func (p *persistence) ReserveQuota(ctx context.Context, tenantID string, day time.Time, count, limit int) (bool, error) {
+	key := quotaKey(tenantID, day)
+
+	total, err := p.redis.IncrBy(ctx, key, int64(count))
+	if err != nil {
+		return false, fmt.Errorf("persistence.redis.IncrBy(): %w", err)
+	}
+
+	if err := p.redis.Expire(ctx, key, quotaTTL); err != nil {
+		return false, fmt.Errorf("persistence.redis.Expire(): %w", err)
+	}
+
+	if total <= int64(limit) {
+		return true, nil
+	}
+
+	if _, err := p.redis.IncrBy(ctx, key, -int64(count)); err != nil {
+		return false, fmt.Errorf("persistence.redis.IncrBy(): %w", err)
+	}
+
+	return false, nil
+}
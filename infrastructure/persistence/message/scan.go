@@ -0,0 +1,81 @@
+package message
+
+import (
+	"time"
+
+	"messager/domain/message"
+)
+
+const columns = `id, tenant_id, created_at, updated_at, content, phone, status, send_at, valid_until, priority, encoding, segments, country_code, region, template_id, locale, campaign_id, category, channel, email, subject, html_content, sender, metadata, tags`
+
+type scanner interface {
+	Scan(destination ...any) error
+}
+
+func scan(scanner scanner, record *message.Message) error {
+	var (
+		validUntil *time.Time
+		templateID *string
+		campaignID *string
+	)
+
+	if err := scanner.Scan(
+		&record.ID, &record.TenantID, &record.CreatedAt, &record.UpdatedAt, &record.Content, &record.Phone, &record.Status,
+		&record.SendAt, &validUntil, &record.Priority, &record.Encoding, &record.Segments,
+		&record.CountryCode, &record.Region, &templateID, &record.Locale, &campaignID, &record.Category,
+		&record.Channel, &record.Email, &record.Subject, &record.HTMLContent, &record.Sender,
+		&record.Metadata, &record.Tags,
+	); err != nil {
+		return err
+	}
+
+	if validUntil != nil {
+		record.ValidUntil = *validUntil
+	}
+
+	if templateID != nil {
+		record.TemplateID = *templateID
+	}
+
+	if campaignID != nil {
+		record.CampaignID = *campaignID
+	}
+
+	return nil
+}
+
+// nonNilMetadata returns an empty map instead of nil so that it is stored as an
+// empty object rather than JSON null.
+func nonNilMetadata(metadata map[string]string) map[string]string {
+	if metadata == nil {
+		return make(map[string]string)
+	}
+
+	return metadata
+}
+
+// nonNilTags returns an empty slice instead of nil so that it is stored as an
+// empty array rather than JSON null.
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return make([]string, 0)
+	}
+
+	return tags
+}
+
+func nullableTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+
+	return &value
+}
+
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
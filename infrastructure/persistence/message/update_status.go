@@ -0,0 +1,33 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/message"
+)
+
+func (p *persistence) UpdateStatus(ctx context.Context, tenantID, id string, from, to message.Status) error {
+	query := `
+		WITH updated AS (
+			UPDATE messages
+			SET status = $1, updated_at = now()
+			WHERE tenant_id = $2 AND id = $3 AND status = $4
+			RETURNING id, tenant_id, status
+		), events AS (
+			INSERT INTO message_events (message_id, from_status, to_status)
+			SELECT id, $4, status FROM updated
+		), outbox AS (
+			INSERT INTO message_outbox (message_id, tenant_id, from_status, to_status)
+			SELECT id, tenant_id, $4, status FROM updated
+		)
+		SELECT id FROM updated;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, to, tenantID, id, from)
+
+	if err := row.Scan(&id); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/infrastructure/database/postgresql"
+)
+
+// Down reverts the latest applied migration and returns it, or nil when none
+// is applied.
+func (m *migrator) Down(ctx context.Context) (*Migration, error) {
+	var reverted *Migration
+
+	err := m.locked(ctx, func(tx postgresql.Tx, appliedAt map[int]time.Time) error {
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+
+			if _, ok := appliedAt[migration.Version]; !ok {
+				continue
+			}
+
+			if err := tx.Exec(ctx, migration.Down); err != nil {
+				return fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			if err := tx.Exec(ctx, `
+				DELETE FROM schema_migrations
+				WHERE version = $1;
+			`, migration.Version); err != nil {
+				return fmt.Errorf("tx.Exec(): %w", err)
+			}
+
+			reverted = &migration
+
+			return nil
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
@@ -0,0 +1,191 @@
+// Package migration versions the schema of the PostgreSQL database with the
+// numbered SQL files in sql/. Every version is a pair of files,
+// NNNN_name.up.sql applying it and NNNN_name.down.sql reverting it, and is
+// recorded in schema_migrations once applied.
+//
+// The first versions are written idempotently, so that databases created
+// before the schema was versioned adopt them as they are.
+package migration
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"messager/infrastructure/database/postgresql"
+)
+
+// lockID is the advisory lock held while migrating, so that only one replica
+// migrates at a time.
+const lockID = 4_137_218_209
+
+//go:embed sql/*.sql
+var files embed.FS
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migrator interface {
+	Up(ctx context.Context) ([]Migration, error)
+	Down(ctx context.Context) (*Migration, error)
+	Status(ctx context.Context) ([]Status, error)
+	Verify(ctx context.Context) error
+}
+
+// Migration is a version of the schema.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration and whether it is applied to the database.
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type migrator struct {
+	postgreSQL postgresql.PostgreSQL
+	migrations []Migration
+}
+
+func New(postgreSQL postgresql.PostgreSQL) (Migrator, error) {
+	sqlFiles, err := fs.Sub(files, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("fs.Sub(): %w", err)
+	}
+
+	migrations, err := parse(sqlFiles)
+	if err != nil {
+		return nil, fmt.Errorf("migration.parse(): %w", err)
+	}
+
+	return &migrator{
+		postgreSQL: postgreSQL,
+		migrations: migrations,
+	}, nil
+}
+
+// parse reads the migrations of fsys in the order of their versions. Every
+// version must have both an up and a down file.
+func parse(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("fs.ReadDir(): %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %q must be named NNNN_name.up.sql or NNNN_name.down.sql", entry.Name())
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration file %q must have a positive version", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("fs.ReadFile(): %w", err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d must have one name, has %q and %q", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration version %d must have an up and a down file", migration.Version)
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return a.Version - b.Version
+	})
+
+	return migrations, nil
+}
+
+// locked runs fn in a transaction holding the migration lock, with the
+// versions applied to the database so far.
+func (m *migrator) locked(ctx context.Context, fn func(tx postgresql.Tx, applied map[int]time.Time) error) error {
+	return m.postgreSQL.Transaction(ctx, func(tx postgresql.Tx) error {
+		if err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1);`, lockID); err != nil {
+			return fmt.Errorf("tx.Exec(): %w", err)
+		}
+
+		if err := tx.Exec(ctx, `
+			CREATE TABLE IF NOT EXISTS schema_migrations (
+				version INTEGER PRIMARY KEY,
+				name VARCHAR(255) NOT NULL,
+				applied_at TIMESTAMP NOT NULL DEFAULT now()
+			);
+		`); err != nil {
+			return fmt.Errorf("tx.Exec(): %w", err)
+		}
+
+		applied, err := findApplied(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		return fn(tx, applied)
+	})
+}
+
+func findApplied(ctx context.Context, tx postgresql.Tx) (map[int]time.Time, error) {
+	rows, err := tx.Query(ctx, `SELECT version, applied_at FROM schema_migrations;`)
+	if err != nil {
+		return nil, fmt.Errorf("tx.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+
+	for rows.Next() {
+		var (
+			version   int
+			appliedAt time.Time
+		)
+
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("tx.Query().Rows.Scan(): %w", err)
+		}
+
+		applied[version] = appliedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("tx.Query().Rows.Err(): %w", err)
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,114 @@
+package migration
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Embedded(t *testing.T) {
+	sqlFiles, err := fs.Sub(files, "sql")
+	assert.NoError(t, err)
+
+	migrations, err := parse(sqlFiles)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+
+	for i, migration := range migrations {
+		assert.Equal(t, i+1, migration.Version, "versions must be consecutive")
+		assert.NotEmpty(t, migration.Up)
+		assert.NotEmpty(t, migration.Down)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        fstest.MapFS
+		wantErr      bool
+		wantVersions []int
+	}{
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"0010_add_index.up.sql":      {Data: []byte("CREATE INDEX;")},
+				"0010_add_index.down.sql":    {Data: []byte("DROP INDEX;")},
+				"0002_create_table.up.sql":   {Data: []byte("CREATE TABLE;")},
+				"0002_create_table.down.sql": {Data: []byte("DROP TABLE;")},
+			},
+			wantVersions: []int{2, 10},
+		},
+		{
+			name: "missing down",
+			files: fstest.MapFS{
+				"0001_create_table.up.sql": {Data: []byte("CREATE TABLE;")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty down",
+			files: fstest.MapFS{
+				"0001_create_table.up.sql":   {Data: []byte("CREATE TABLE;")},
+				"0001_create_table.down.sql": {Data: []byte("")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid name",
+			files: fstest.MapFS{
+				"create_table.sql": {Data: []byte("CREATE TABLE;")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero version",
+			files: fstest.MapFS{
+				"0000_create_table.up.sql":   {Data: []byte("CREATE TABLE;")},
+				"0000_create_table.down.sql": {Data: []byte("DROP TABLE;")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "one version with two names",
+			files: fstest.MapFS{
+				"0001_create_table.up.sql":   {Data: []byte("CREATE TABLE;")},
+				"0001_create_index.down.sql": {Data: []byte("DROP INDEX;")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := parse(tt.files)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			versions := make([]int, 0, len(migrations))
+			for _, migration := range migrations {
+				versions = append(versions, migration.Version)
+			}
+
+			assert.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	mode, err := ParseMode("")
+	assert.NoError(t, err)
+	assert.Equal(t, ModeUp, mode)
+
+	mode, err = ParseMode("verify")
+	assert.NoError(t, err)
+	assert.Equal(t, ModeVerify, mode)
+
+	_, err = ParseMode("down")
+	assert.Error(t, err)
+}
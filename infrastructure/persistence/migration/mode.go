@@ -0,0 +1,26 @@
+package migration
+
+import "fmt"
+
+const (
+	// ModeUp applies the pending migrations on start.
+	ModeUp Mode = "up"
+	// ModeVerify refuses to start with pending migrations, which are applied
+	// with the migrate command instead.
+	ModeVerify Mode = "verify"
+)
+
+// Mode is how the schema is handled when messager starts.
+type Mode string
+
+// ParseMode returns the mode named value, ModeUp when value is empty.
+func ParseMode(value string) (Mode, error) {
+	switch Mode(value) {
+	case "", ModeUp:
+		return ModeUp, nil
+	case ModeVerify:
+		return ModeVerify, nil
+	default:
+		return "", fmt.Errorf("migration mode %q must be one of up or verify", value)
+	}
+}
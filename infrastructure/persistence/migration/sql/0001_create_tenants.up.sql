@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS tenants (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	updated_at TIMESTAMP NOT NULL DEFAULT now(),
+	name VARCHAR(100) NOT NULL,
+	default_region VARCHAR(2) NOT NULL DEFAULT '',
+	daily_quota INTEGER NOT NULL DEFAULT 0,
+	paused BOOLEAN NOT NULL DEFAULT false
+);
+
+INSERT INTO tenants (id, name)
+VALUES ('00000000-0000-0000-0000-000000000000', 'default')
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS message_events;
+DROP TABLE IF EXISTS messages;
+
+DROP TYPE IF EXISTS message_channel;
+DROP TYPE IF EXISTS message_category;
+DROP TYPE IF EXISTS message_priority;
+DROP TYPE IF EXISTS message_status;
@@ -0,0 +1,82 @@
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'message_status') THEN
+		CREATE TYPE message_status AS ENUM ('PENDING', 'QUEUED', 'SENDING', 'SENT', 'DELIVERED', 'FAILED', 'CANCELLED', 'EXPIRED');
+	END IF;
+END $$;
+
+ALTER TYPE message_status ADD VALUE IF NOT EXISTS 'QUEUED';
+ALTER TYPE message_status ADD VALUE IF NOT EXISTS 'SENDING';
+ALTER TYPE message_status ADD VALUE IF NOT EXISTS 'DELIVERED';
+ALTER TYPE message_status ADD VALUE IF NOT EXISTS 'FAILED';
+ALTER TYPE message_status ADD VALUE IF NOT EXISTS 'CANCELLED';
+ALTER TYPE message_status ADD VALUE IF NOT EXISTS 'EXPIRED';
+
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'message_priority') THEN
+		CREATE TYPE message_priority AS ENUM ('TRANSACTIONAL', 'NORMAL', 'BULK');
+	END IF;
+END $$;
+
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'message_category') THEN
+		CREATE TYPE message_category AS ENUM ('TRANSACTIONAL', 'MARKETING');
+	END IF;
+END $$;
+
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'message_channel') THEN
+		CREATE TYPE message_channel AS ENUM ('SMS', 'EMAIL');
+	END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS messages (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	updated_at TIMESTAMP NOT NULL DEFAULT now(),
+	content TEXT NOT NULL,
+	phone VARCHAR(255) NOT NULL,
+	status message_status NOT NULL
+);
+
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS send_at TIMESTAMP NOT NULL DEFAULT now();
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS valid_until TIMESTAMP;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS priority message_priority NOT NULL DEFAULT 'NORMAL';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS encoding VARCHAR(8) NOT NULL DEFAULT 'GSM-7';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS segments INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS country_code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS region VARCHAR(3) NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS template_id UUID;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS campaign_id UUID;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS category message_category NOT NULL DEFAULT 'MARKETING';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS channel message_channel NOT NULL DEFAULT 'SMS';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS email VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS subject VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS html_content TEXT NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS sender VARCHAR(16) NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS tags JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+DROP INDEX IF EXISTS messages_status_send_at_idx;
+CREATE INDEX IF NOT EXISTS messages_status_priority_send_at_idx ON messages (status, priority, send_at);
+CREATE INDEX IF NOT EXISTS messages_status_valid_until_idx ON messages (status, valid_until) WHERE valid_until IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS messages_campaign_id_status_idx ON messages (campaign_id, status) WHERE campaign_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS messages_metadata_idx ON messages USING GIN (metadata);
+CREATE INDEX IF NOT EXISTS messages_tags_idx ON messages USING GIN (tags);
+
+CREATE INDEX IF NOT EXISTS messages_tenant_id_status_priority_idx ON messages (tenant_id, status, priority, created_at);
+
+ALTER TABLE messages REPLICA IDENTITY FULL;
+
+CREATE TABLE IF NOT EXISTS message_events (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	message_id UUID NOT NULL REFERENCES messages (id) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL DEFAULT clock_timestamp(),
+	from_status message_status,
+	to_status message_status NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS message_events_message_id_idx ON message_events (message_id, created_at);
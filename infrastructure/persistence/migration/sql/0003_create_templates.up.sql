@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS templates (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	updated_at TIMESTAMP NOT NULL DEFAULT now(),
+	name VARCHAR(255) NOT NULL,
+	default_locale VARCHAR(35) NOT NULL,
+	variants JSONB NOT NULL
+);
+
+ALTER TABLE templates ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+CREATE INDEX IF NOT EXISTS templates_tenant_id_idx ON templates (tenant_id);
@@ -0,0 +1,28 @@
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'campaign_status') THEN
+		CREATE TYPE campaign_status AS ENUM ('DRAFT', 'RUNNING', 'PAUSED', 'CANCELLED');
+	END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS campaigns (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	updated_at TIMESTAMP NOT NULL DEFAULT now(),
+	name VARCHAR(255) NOT NULL,
+	status campaign_status NOT NULL,
+	content TEXT NOT NULL DEFAULT '',
+	template_id UUID,
+	locale VARCHAR(35) NOT NULL DEFAULT '',
+	variables JSONB,
+	send_at TIMESTAMP,
+	time_zone VARCHAR(64) NOT NULL DEFAULT '',
+	valid_until TIMESTAMP,
+	priority VARCHAR(16) NOT NULL,
+	recipient_count INTEGER NOT NULL
+);
+
+ALTER TABLE campaigns ADD COLUMN IF NOT EXISTS category VARCHAR(16) NOT NULL DEFAULT 'MARKETING';
+ALTER TABLE campaigns ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+CREATE INDEX IF NOT EXISTS campaigns_status_idx ON campaigns (status);
+CREATE INDEX IF NOT EXISTS campaigns_tenant_id_created_at_idx ON campaigns (tenant_id, created_at DESC);
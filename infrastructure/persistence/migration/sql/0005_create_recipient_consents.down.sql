@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS recipient_consents;
+
+DROP TYPE IF EXISTS consent_status;
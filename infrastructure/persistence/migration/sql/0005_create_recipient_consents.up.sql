@@ -0,0 +1,20 @@
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'consent_status') THEN
+		CREATE TYPE consent_status AS ENUM ('OPTED_IN', 'OPTED_OUT');
+	END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS recipient_consents (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	created_at TIMESTAMP NOT NULL DEFAULT clock_timestamp(),
+	phone VARCHAR(255) NOT NULL,
+	status consent_status NOT NULL,
+	source VARCHAR(255) NOT NULL,
+	category VARCHAR(16) NOT NULL
+);
+
+ALTER TABLE recipient_consents ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+DROP INDEX IF EXISTS recipient_consents_phone_category_created_at_idx;
+CREATE INDEX IF NOT EXISTS recipient_consents_tenant_id_phone_category_created_at_idx
+	ON recipient_consents (tenant_id, phone, category, created_at DESC);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS content_policies (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	name VARCHAR(255) NOT NULL,
+	forbidden_words TEXT[] NOT NULL DEFAULT '{}',
+	forbidden_patterns TEXT[] NOT NULL DEFAULT '{}',
+	allowed_domains TEXT[] NOT NULL DEFAULT '{}',
+	required_footer TEXT NOT NULL DEFAULT '',
+	max_links INTEGER NOT NULL DEFAULT 0
+);
+
+ALTER TABLE content_policies ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+CREATE INDEX IF NOT EXISTS content_policies_tenant_id_idx ON content_policies (tenant_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS senders;
+
+DROP TYPE IF EXISTS sender_type;
@@ -0,0 +1,17 @@
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'sender_type') THEN
+		CREATE TYPE sender_type AS ENUM ('NUMBER', 'ALPHANUMERIC');
+	END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS senders (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	type sender_type NOT NULL,
+	value VARCHAR(16) NOT NULL,
+	countries VARCHAR(2)[] NOT NULL DEFAULT '{}'
+);
+
+ALTER TABLE senders ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE senders DROP CONSTRAINT IF EXISTS senders_value_key;
+CREATE UNIQUE INDEX IF NOT EXISTS senders_tenant_id_value_idx ON senders (tenant_id, value);
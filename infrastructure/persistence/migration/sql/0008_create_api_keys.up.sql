@@ -0,0 +1,17 @@
+DO $$ BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'api_key_role') THEN
+		CREATE TYPE api_key_role AS ENUM ('READ_ONLY', 'SENDER', 'ADMIN');
+	END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS api_keys (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id UUID NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	name VARCHAR(100) NOT NULL,
+	role api_key_role NOT NULL,
+	prefix VARCHAR(12) NOT NULL,
+	hash CHAR(64) NOT NULL UNIQUE
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_tenant_id_idx ON api_keys (tenant_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS messages_content_search_idx;
+DROP INDEX IF EXISTS messages_tenant_id_phone_created_at_idx;
+DROP INDEX IF EXISTS messages_tenant_id_status_created_at_id_idx;
+DROP INDEX IF EXISTS messages_tenant_id_created_at_id_idx;
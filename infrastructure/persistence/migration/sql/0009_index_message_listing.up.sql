@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS messages_tenant_id_created_at_id_idx ON messages (tenant_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS messages_tenant_id_status_created_at_id_idx ON messages (tenant_id, status, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS messages_tenant_id_phone_created_at_idx ON messages (tenant_id, phone, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS messages_content_search_idx ON messages USING GIN (to_tsvector('simple', content));
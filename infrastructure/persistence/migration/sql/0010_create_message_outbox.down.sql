@@ -0,0 +1,3 @@
+ALTER TABLE messages REPLICA IDENTITY FULL;
+
+DROP TABLE IF EXISTS message_outbox;
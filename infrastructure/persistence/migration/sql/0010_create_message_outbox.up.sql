@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS message_outbox (
+	id BIGSERIAL PRIMARY KEY,
+	message_id UUID NOT NULL,
+	tenant_id UUID NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT clock_timestamp(),
+	from_status message_status,
+	to_status message_status NOT NULL
+);
+
+ALTER TABLE messages REPLICA IDENTITY DEFAULT;
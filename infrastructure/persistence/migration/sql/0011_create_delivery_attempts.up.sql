@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS delivery_attempts (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	message_id UUID NOT NULL REFERENCES messages (id) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL DEFAULT clock_timestamp(),
+	provider VARCHAR(255) NOT NULL,
+	provider_message_id VARCHAR(255) NOT NULL DEFAULT '',
+	status VARCHAR(16) NOT NULL,
+	response_status INTEGER NOT NULL DEFAULT 0,
+	latency_ms BIGINT NOT NULL,
+	error_class VARCHAR(16) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS delivery_attempts_message_id_idx ON delivery_attempts (message_id, created_at);
+CREATE INDEX IF NOT EXISTS delivery_attempts_provider_message_id_idx ON delivery_attempts (provider, provider_message_id) WHERE provider_message_id <> '';
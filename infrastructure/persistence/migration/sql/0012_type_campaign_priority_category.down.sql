@@ -0,0 +1,5 @@
+ALTER TABLE campaigns ALTER COLUMN priority TYPE VARCHAR(16) USING priority::TEXT;
+
+ALTER TABLE campaigns ALTER COLUMN category DROP DEFAULT;
+ALTER TABLE campaigns ALTER COLUMN category TYPE VARCHAR(16) USING category::TEXT;
+ALTER TABLE campaigns ALTER COLUMN category SET DEFAULT 'MARKETING';
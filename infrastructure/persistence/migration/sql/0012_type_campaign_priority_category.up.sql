@@ -0,0 +1,5 @@
+ALTER TABLE campaigns ALTER COLUMN priority TYPE message_priority USING priority::message_priority;
+
+ALTER TABLE campaigns ALTER COLUMN category DROP DEFAULT;
+ALTER TABLE campaigns ALTER COLUMN category TYPE message_category USING category::message_category;
+ALTER TABLE campaigns ALTER COLUMN category SET DEFAULT 'MARKETING';
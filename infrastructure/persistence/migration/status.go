@@ -0,0 +1,61 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Status returns every migration and whether it is applied. It neither
+// changes the database nor waits for a replica migrating it.
+func (m *migrator) Status(ctx context.Context) ([]Status, error) {
+	var exists bool
+
+	if err := m.postgreSQL.QueryRow(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL;`).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	appliedAt := make(map[int]time.Time)
+
+	if exists {
+		var err error
+
+		if appliedAt, err = findApplied(ctx, m.postgreSQL); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+
+	for _, migration := range m.migrations {
+		at, ok := appliedAt[migration.Version]
+
+		statuses = append(statuses, Status{
+			Migration: migration,
+			Applied:   ok,
+			AppliedAt: at,
+		})
+	}
+
+	return statuses, nil
+}
+
+// Verify returns an error when a migration is not applied, for replicas that
+// must not change the schema themselves.
+func (m *migrator) Verify(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, status := range statuses {
+		if !status.Applied {
+			errs = append(errs, fmt.Errorf("migration %04d_%s is not applied", status.Version, status.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,43 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"messager/infrastructure/database/postgresql"
+)
+
+// Up applies the migrations not applied yet, in the order of their versions,
+// and returns them. Either all of them are applied or none is.
+func (m *migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.locked(ctx, func(tx postgresql.Tx, appliedAt map[int]time.Time) error {
+		for _, migration := range m.migrations {
+			if _, ok := appliedAt[migration.Version]; ok {
+				continue
+			}
+
+			if err := tx.Exec(ctx, migration.Up); err != nil {
+				return fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			if err := tx.Exec(ctx, `
+				INSERT INTO schema_migrations (version, name)
+				VALUES ($1, $2);
+			`, migration.Version, migration.Name); err != nil {
+				return fmt.Errorf("tx.Exec(): %w", err)
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,24 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/policy"
+)
+
+func (p *persistence) Create(ctx context.Context, policy *policy.Policy) error {
+	query := `
+		INSERT INTO content_policies (tenant_id, name, forbidden_words, forbidden_patterns, allowed_domains, required_footer, max_links)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, policy.TenantID, policy.Name, nonNil(policy.ForbiddenWords), nonNil(policy.ForbiddenPatterns),
+		nonNil(policy.AllowedDomains), policy.RequiredFooter, policy.MaxLinks)
+
+	if err := row.Scan(&policy.ID, &policy.CreatedAt); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/policy"
+)
+
+func (p *persistence) FindAll(ctx context.Context, tenantID string) ([]policy.Policy, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM content_policies
+		WHERE tenant_id = $1
+		ORDER BY name, created_at;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []policy.Policy
+
+	for rows.Next() {
+		var record policy.Policy
+
+		if err := scan(rows, &record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
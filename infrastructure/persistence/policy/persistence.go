@@ -0,0 +1,18 @@
+package policy
+
+import (
+	"messager/domain/policy"
+	"messager/infrastructure/database/postgresql"
+)
+
+type persistence struct {
+	postgreSQL postgresql.PostgreSQL
+}
+
+func New(postgreSQL postgresql.PostgreSQL) policy.Repository {
+	p := persistence{
+		postgreSQL: postgreSQL,
+	}
+
+	return &p
+}
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"messager/domain/policy"
+)
+
+const columns = `id, tenant_id, created_at, name, forbidden_words, forbidden_patterns, allowed_domains, required_footer, max_links`
+
+type scanner interface {
+	Scan(destination ...any) error
+}
+
+func scan(scanner scanner, record *policy.Policy) error {
+	return scanner.Scan(
+		&record.ID, &record.TenantID, &record.CreatedAt, &record.Name, &record.ForbiddenWords, &record.ForbiddenPatterns,
+		&record.AllowedDomains, &record.RequiredFooter, &record.MaxLinks,
+	)
+}
+
+// nonNil returns an empty slice instead of nil so that it is stored as an
+// empty array.
+func nonNil(values []string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+
+	return values
+}
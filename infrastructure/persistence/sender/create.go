@@ -0,0 +1,26 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/sender"
+)
+
+// Create inserts the sender unless its value is already in the pool of its
+// tenant, in which case the row is not returned.
+func (p *persistence) Create(ctx context.Context, sender *sender.Sender) error {
+	query := `
+		INSERT INTO senders (tenant_id, type, value, countries)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id, value) DO NOTHING
+		RETURNING id, created_at;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, sender.TenantID, sender.Type, sender.Value, nonNil(sender.Countries))
+
+	if err := row.Scan(&sender.ID, &sender.CreatedAt); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/sender"
+)
+
+func (p *persistence) FindAll(ctx context.Context, tenantID string) ([]sender.Sender, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM senders
+		WHERE tenant_id = $1
+		ORDER BY created_at, value;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []sender.Sender
+
+	for rows.Next() {
+		var record sender.Sender
+
+		if err := scan(rows, &record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,18 @@
+package sender
+
+import (
+	"messager/domain/sender"
+	"messager/infrastructure/database/postgresql"
+)
+
+type persistence struct {
+	postgreSQL postgresql.PostgreSQL
+}
+
+func New(postgreSQL postgresql.PostgreSQL) sender.Repository {
+	p := persistence{
+		postgreSQL: postgreSQL,
+	}
+
+	return &p
+}
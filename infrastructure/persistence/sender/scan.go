@@ -0,0 +1,25 @@
+package sender
+
+import (
+	"messager/domain/sender"
+)
+
+const columns = `id, tenant_id, created_at, type, value, countries`
+
+type scanner interface {
+	Scan(destination ...any) error
+}
+
+func scan(scanner scanner, record *sender.Sender) error {
+	return scanner.Scan(&record.ID, &record.TenantID, &record.CreatedAt, &record.Type, &record.Value, &record.Countries)
+}
+
+// nonNil returns an empty slice instead of nil so that it is stored as an
+// empty array.
+func nonNil(values []string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+
+	return values
+}
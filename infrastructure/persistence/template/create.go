@@ -0,0 +1,28 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/template"
+)
+
+func (p *persistence) Create(ctx context.Context, template *template.Template) error {
+	variants, err := marshalVariants(template.Variants)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO templates (tenant_id, name, default_locale, variants)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, template.TenantID, template.Name, template.DefaultLocale, variants)
+
+	if err := row.Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
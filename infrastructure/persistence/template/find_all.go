@@ -0,0 +1,41 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/template"
+)
+
+func (p *persistence) FindAll(ctx context.Context, tenantID string) ([]template.Template, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM templates
+		WHERE tenant_id = $1
+		ORDER BY name, created_at;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []template.Template
+
+	for rows.Next() {
+		var record template.Template
+
+		if err := scan(rows, &record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,25 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/template"
+)
+
+func (p *persistence) FindByID(ctx context.Context, tenantID, id string) (*template.Template, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM templates
+		WHERE tenant_id = $1 AND id = $2
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, tenantID, id)
+
+	var record template.Template
+
+	if err := scan(row, &record); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return &record, nil
+}
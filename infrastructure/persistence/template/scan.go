@@ -0,0 +1,64 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"messager/domain/template"
+)
+
+const columns = `id, tenant_id, created_at, updated_at, name, default_locale, variants`
+
+type scanner interface {
+	Scan(destination ...any) error
+}
+
+type variantRecord struct {
+	Locale  string `json:"locale"`
+	Content string `json:"content"`
+}
+
+func scan(scanner scanner, record *template.Template) error {
+	var variants []byte
+
+	if err := scanner.Scan(
+		&record.ID, &record.TenantID, &record.CreatedAt, &record.UpdatedAt, &record.Name, &record.DefaultLocale, &variants,
+	); err != nil {
+		return err
+	}
+
+	var variantRecords []variantRecord
+
+	if err := json.Unmarshal(variants, &variantRecords); err != nil {
+		return fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	record.Variants = make([]template.Variant, 0, len(variantRecords))
+
+	for _, variant := range variantRecords {
+		record.Variants = append(record.Variants, template.Variant{
+			Locale:  variant.Locale,
+			Content: variant.Content,
+		})
+	}
+
+	return nil
+}
+
+func marshalVariants(variants []template.Variant) ([]byte, error) {
+	variantRecords := make([]variantRecord, 0, len(variants))
+
+	for _, variant := range variants {
+		variantRecords = append(variantRecords, variantRecord{
+			Locale:  variant.Locale,
+			Content: variant.Content,
+		})
+	}
+
+	data, err := json.Marshal(variantRecords)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	return data, nil
+}
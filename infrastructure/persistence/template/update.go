@@ -0,0 +1,29 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/template"
+)
+
+func (p *persistence) Update(ctx context.Context, template *template.Template) error {
+	variants, err := marshalVariants(template.Variants)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE templates
+		SET name = $1, default_locale = $2, variants = $3, updated_at = now()
+		WHERE tenant_id = $4 AND id = $5
+		RETURNING created_at, updated_at;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, template.Name, template.DefaultLocale, variants, template.TenantID, template.ID)
+
+	if err := row.Scan(&template.CreatedAt, &template.UpdatedAt); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
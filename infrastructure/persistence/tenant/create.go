@@ -0,0 +1,23 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/tenant"
+)
+
+func (p *persistence) Create(ctx context.Context, tenant *tenant.Tenant) error {
+	query := `
+		INSERT INTO tenants (name, default_region, daily_quota)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at, paused;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, tenant.Name, tenant.DefaultRegion, tenant.DailyQuota)
+
+	if err := row.Scan(&tenant.ID, &tenant.CreatedAt, &tenant.UpdatedAt, &tenant.Paused); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/tenant"
+)
+
+func (p *persistence) FindAll(ctx context.Context) ([]tenant.Tenant, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM tenants
+		ORDER BY created_at, name;
+	`
+	rows, err := p.postgreSQL.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query(): %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []tenant.Tenant
+
+	for rows.Next() {
+		var record tenant.Tenant
+
+		if err := scan(rows, &record); err != nil {
+			return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Scan(): %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.Query().Rows.Err(): %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,25 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/tenant"
+)
+
+func (p *persistence) FindByID(ctx context.Context, id string) (*tenant.Tenant, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM tenants
+		WHERE id = $1
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, id)
+
+	var record tenant.Tenant
+
+	if err := scan(row, &record); err != nil {
+		return nil, fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return &record, nil
+}
@@ -0,0 +1,18 @@
+package tenant
+
+import (
+	"messager/domain/tenant"
+)
+
+const columns = `id, created_at, updated_at, name, default_region, daily_quota, paused`
+
+type scanner interface {
+	Scan(destination ...any) error
+}
+
+func scan(scanner scanner, record *tenant.Tenant) error {
+	return scanner.Scan(
+		&record.ID, &record.CreatedAt, &record.UpdatedAt, &record.Name, &record.DefaultRegion, &record.DailyQuota,
+		&record.Paused,
+	)
+}
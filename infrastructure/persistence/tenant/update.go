@@ -0,0 +1,24 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"messager/domain/tenant"
+)
+
+func (p *persistence) Update(ctx context.Context, tenant *tenant.Tenant) error {
+	query := `
+		UPDATE tenants
+		SET name = $1, default_region = $2, daily_quota = $3, updated_at = now()
+		WHERE id = $4
+		RETURNING created_at, updated_at, paused;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, tenant.Name, tenant.DefaultRegion, tenant.DailyQuota, tenant.ID)
+
+	if err := row.Scan(&tenant.CreatedAt, &tenant.UpdatedAt, &tenant.Paused); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
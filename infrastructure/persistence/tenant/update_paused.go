@@ -0,0 +1,22 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+)
+
+func (p *persistence) UpdatePaused(ctx context.Context, id string, paused bool) error {
+	query := `
+		UPDATE tenants
+		SET paused = $1, updated_at = now()
+		WHERE id = $2
+		RETURNING id;
+	`
+	row := p.postgreSQL.QueryRow(ctx, query, paused, id)
+
+	if err := row.Scan(&id); err != nil {
+		return fmt.Errorf("persistence.postgreSQL.QueryRow().Row.Scan(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type Config struct {
+	Brokers []string
+	Topic   string
+}
+
+type publisher struct {
+	writer *kafka.Writer
+}
+
+type key struct {
+	ID string `json:"id"`
+}
+
+type value struct {
+	ID        int64  `json:"id"`
+	MessageID string `json:"messageId"`
+	TenantID  string `json:"tenantId"`
+	CreatedAt string `json:"createdAt"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to"`
+}
+
+// New returns a publisher writing outbox entries to a Kafka topic, keyed by
+// the message ID so that the entries of a message share a partition and stay
+// in order.
+func New(config Config) message.Publisher {
+	return &publisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(config.Brokers...),
+			Topic:                  config.Topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			MaxAttempts:            3,
+			BatchTimeout:           10 * time.Millisecond,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+func (p *publisher) Publish(ctx context.Context, entries []message.OutboxEntry) error {
+	messages := make([]kafka.Message, 0, len(entries))
+
+	for _, entry := range entries {
+		entryKey, err := json.Marshal(key{ID: entry.MessageID})
+		if err != nil {
+			return fmt.Errorf("json.Marshal(): %w", err)
+		}
+
+		entryValue, err := json.Marshal(value{
+			ID:        entry.ID,
+			MessageID: entry.MessageID,
+			TenantID:  entry.TenantID,
+			CreatedAt: entry.CreatedAt.Format(time.RFC3339Nano),
+			From:      string(entry.From),
+			To:        string(entry.To),
+		})
+		if err != nil {
+			return fmt.Errorf("json.Marshal(): %w", err)
+		}
+
+		messages = append(messages, kafka.Message{
+			Key:   entryKey,
+			Value: entryValue,
+		})
+	}
+
+	if err := p.writer.WriteMessages(ctx, messages...); err != nil {
+		return fmt.Errorf("publisher.writer.WriteMessages(): %w", err)
+	}
+
+	return nil
+}
+
+func (p *publisher) Close() error {
+	if err := p.writer.Close(); err != nil {
+		return fmt.Errorf("publisher.writer.Close(): %w", err)
+	}
+
+	return nil
+}
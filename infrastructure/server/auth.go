@@ -0,0 +1,83 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerScheme        = "Bearer "
+)
+
+const (
+	RolePublic Role = iota
+	RoleReadOnly
+	RoleSender
+	RoleAdmin
+)
+
+var (
+	errMissingCredentials   = errors.New("authorization header must provide a bearer api key")
+	errMissingAuthenticator = errors.New("route requires authentication but no authenticator is configured")
+)
+
+// Role is the access a route requires. Every role is allowed the routes of the
+// roles before it.
+type Role uint8
+
+// Identity is the caller of a request, as authenticated by OnAuthenticate.
+type Identity struct {
+	KeyID    string
+	Name     string
+	TenantID string
+	Role     Role
+}
+
+func (r Role) String() string {
+	switch r {
+	case RolePublic:
+		return "PUBLIC"
+	case RoleReadOnly:
+		return "READ_ONLY"
+	case RoleSender:
+		return "SENDER"
+	case RoleAdmin:
+		return "ADMIN"
+	default:
+		return fmt.Sprintf("Role(%d)", r)
+	}
+}
+
+// authenticate populates the identity and context of a request to a route
+// requiring role. Requests to public routes are not authenticated. Requests to
+// other routes fail when no authenticator is configured.
+func (r *router) authenticate(ctx *requestContext, role Role) error {
+	if role == RolePublic {
+		return nil
+	}
+
+	if r.onAuthenticate == nil {
+		return errMissingAuthenticator
+	}
+
+	credentials, ok := strings.CutPrefix(ctx.GetHeader(authorizationHeader), bearerScheme)
+	if !ok || credentials == "" {
+		return ctx.NewError(StatusUnauthorized, "Unauthorized.", errMissingCredentials)
+	}
+
+	authCtx, identity, err := r.onAuthenticate(ctx, credentials)
+	if err != nil {
+		return err
+	}
+
+	if identity.Role < role {
+		return ctx.NewError(StatusForbidden, "Forbidden.", fmt.Errorf("route requires the %s role", role))
+	}
+
+	ctx.context = authCtx
+	ctx.identity = identity
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type contextKey struct{}
+
+func TestRouter_Authenticate(t *testing.T) {
+	tests := []struct {
+		name            string
+		route           Role
+		header          string
+		identity        Identity
+		err             error
+		noAuthenticator bool
+		wantStatus      int
+		wantCredential  string
+	}{
+		{
+			name:       "public route without header",
+			route:      RolePublic,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "public route with an invalid key",
+			route:      RolePublic,
+			header:     "Bearer invalid",
+			err:        errors.New("must not be called"),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:            "public route without an authenticator",
+			route:           RolePublic,
+			noAuthenticator: true,
+			wantStatus:      http.StatusOK,
+		},
+		{
+			name:            "route without an authenticator",
+			route:           RoleAdmin,
+			header:          "Bearer secret",
+			noAuthenticator: true,
+			wantStatus:      http.StatusInternalServerError,
+		},
+		{
+			name:       "missing header",
+			route:      RoleReadOnly,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "basic scheme",
+			route:      RoleReadOnly,
+			header:     "Basic dXNlcjpwYXNz",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "bearer without a key",
+			route:      RoleReadOnly,
+			header:     "Bearer ",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "lowercase scheme",
+			route:      RoleReadOnly,
+			header:     "bearer secret",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "rejected key",
+			route:          RoleReadOnly,
+			header:         "Bearer secret",
+			err:            &requestError{status: StatusUnauthorized, message: "Unauthorized."},
+			wantStatus:     http.StatusUnauthorized,
+			wantCredential: "secret",
+		},
+		{
+			name:           "authenticator failure",
+			route:          RoleReadOnly,
+			header:         "Bearer secret",
+			err:            errors.New("database unavailable"),
+			wantStatus:     http.StatusInternalServerError,
+			wantCredential: "secret",
+		},
+		{
+			name:           "same role",
+			route:          RoleSender,
+			header:         "Bearer secret",
+			identity:       Identity{KeyID: "key", Role: RoleSender},
+			wantStatus:     http.StatusOK,
+			wantCredential: "secret",
+		},
+		{
+			name:           "higher role",
+			route:          RoleReadOnly,
+			header:         "Bearer secret",
+			identity:       Identity{KeyID: "key", Role: RoleAdmin},
+			wantStatus:     http.StatusOK,
+			wantCredential: "secret",
+		},
+		{
+			name:           "lower role",
+			route:          RoleAdmin,
+			header:         "Bearer secret",
+			identity:       Identity{KeyID: "key", Role: RoleSender},
+			wantStatus:     http.StatusForbidden,
+			wantCredential: "secret",
+		},
+		{
+			// A key whose role is missing from the roles it is mapped with
+			// gets the zero RolePublic, which is only allowed public routes.
+			name:           "unknown key role",
+			route:          RoleReadOnly,
+			header:         "Bearer secret",
+			identity:       Identity{KeyID: "key", Role: map[string]Role{"ADMIN": RoleAdmin}["OWNER"]},
+			wantStatus:     http.StatusForbidden,
+			wantCredential: "secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var credentials string
+
+			r := &router{
+				mux: http.NewServeMux(),
+				onAuthenticate: func(ctx RequestContext, c string) (context.Context, Identity, error) {
+					credentials = c
+
+					if tt.err != nil {
+						return nil, Identity{}, tt.err
+					}
+
+					return context.WithValue(ctx.Context(), contextKey{}, c), tt.identity, nil
+				},
+			}
+
+			if tt.noAuthenticator {
+				r.onAuthenticate = nil
+			}
+
+			var handled RequestContext
+
+			r.AddRoute("GET /resource", tt.route, func(ctx RequestContext) (any, error) {
+				handled = ctx
+
+				return map[string]string{}, nil
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/resource", nil)
+			if tt.header != "" {
+				request.Header.Set(authorizationHeader, tt.header)
+			}
+
+			recorder := httptest.NewRecorder()
+			r.mux.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			assert.Equal(t, tt.wantCredential, credentials)
+
+			if tt.wantStatus != http.StatusOK {
+				assert.Nil(t, handled)
+
+				return
+			}
+
+			if tt.route == RolePublic {
+				assert.Equal(t, Identity{}, handled.GetIdentity())
+
+				return
+			}
+
+			assert.Equal(t, tt.identity, handled.GetIdentity())
+			assert.Equal(t, "secret", handled.Context().Value(contextKey{}))
+		})
+	}
+}
+
+func TestRole_String(t *testing.T) {
+	assert.Equal(t, "PUBLIC", RolePublic.String())
+	assert.Equal(t, "READ_ONLY", RoleReadOnly.String())
+	assert.Equal(t, "SENDER", RoleSender.String())
+	assert.Equal(t, "ADMIN", RoleAdmin.String())
+	assert.Equal(t, "Role(9)", Role(9).String())
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	problemType        = "about:blank"
+
+	CodeInvalidRequest  = "INVALID_REQUEST"
+	CodeUnauthorized    = "UNAUTHORIZED"
+	CodeForbidden       = "FORBIDDEN"
+	CodeNotFound        = "NOT_FOUND"
+	CodeConflict        = "CONFLICT"
+	CodeUnprocessable   = "UNPROCESSABLE_ENTITY"
+	CodeTooManyRequests = "TOO_MANY_REQUESTS"
+	CodeInternal        = "INTERNAL_ERROR"
+)
+
+// ErrorResponse is an RFC 7807 problem. Code is a stable identifier of the
+// error and errors lists the invalid fields of the request.
+type ErrorResponse struct {
+	Type     string               `json:"type" example:"about:blank"`
+	Title    string               `json:"title" example:"Invalid request."`
+	Status   uint16               `json:"status" example:"400"`
+	Detail   string               `json:"detail" example:"message does not valid for create\nmessage content must be provided"`
+	Instance string               `json:"instance,omitempty" example:"/messages"`
+	Code     string               `json:"code" example:"MESSAGE_INVALID_FOR_CREATE"`
+	Errors   []FieldErrorResponse `json:"errors,omitempty"`
+}
+
+type FieldErrorResponse struct {
+	Field  string         `json:"field" example:"content"`
+	Code   string         `json:"code" example:"REQUIRED"`
+	Detail string         `json:"detail" example:"message content must be provided"`
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// coded is implemented by errors with a stable code.
+type coded interface {
+	error
+	Code() string
+}
+
+// fieldError is implemented by errors of a single field of a request.
+type fieldError interface {
+	coded
+	Field() string
+	Params() map[string]any
+}
+
+// newErrorResponse describes err to clients. Unexpected errors are only
+// described by their title, so that internal details do not leak.
+func newErrorResponse(status uint16, title string, err error, instance string) ErrorResponse {
+	if status == http.StatusInternalServerError {
+		return ErrorResponse{
+			Type:     problemType,
+			Title:    title,
+			Status:   status,
+			Detail:   title,
+			Instance: instance,
+			Code:     CodeInternal,
+		}
+	}
+
+	return ErrorResponse{
+		Type:     problemType,
+		Title:    title,
+		Status:   status,
+		Detail:   err.Error(),
+		Instance: instance,
+		Code:     errorCode(status, err),
+		Errors:   FieldErrors(err),
+	}
+}
+
+// ErrorCode returns the code of the first coded error in the tree of err, or
+// an empty string when it has none.
+func ErrorCode(err error) string {
+	var codedErr coded
+	if errors.As(err, &codedErr) {
+		return codedErr.Code()
+	}
+
+	return ""
+}
+
+// FieldErrors returns every field error in the tree of err.
+func FieldErrors(err error) []FieldErrorResponse {
+	if fieldErr, ok := err.(fieldError); ok {
+		return []FieldErrorResponse{{
+			Field:  fieldErr.Field(),
+			Code:   fieldErr.Code(),
+			Detail: fieldErr.Error(),
+			Params: fieldErr.Params(),
+		}}
+	}
+
+	switch err := err.(type) {
+	case interface{ Unwrap() []error }:
+		var fieldErrs []FieldErrorResponse
+
+		for _, err := range err.Unwrap() {
+			fieldErrs = append(fieldErrs, FieldErrors(err)...)
+		}
+
+		return fieldErrs
+	case interface{ Unwrap() error }:
+		return FieldErrors(err.Unwrap())
+	default:
+		return nil
+	}
+}
+
+// errorCode returns the code of err. Errors without a code fall back to a
+// code of their status.
+func errorCode(status uint16, err error) string {
+	if code := ErrorCode(err); code != "" {
+		return code
+	}
+
+	switch status {
+	case StatusUnauthorized:
+		return CodeUnauthorized
+	case StatusForbidden:
+		return CodeForbidden
+	case StatusNotFound:
+		return CodeNotFound
+	case StatusConflict:
+		return CodeConflict
+	case StatusUnprocessableEntity:
+		return CodeUnprocessable
+	case StatusTooManyRequests:
+		return CodeTooManyRequests
+	default:
+		return CodeInvalidRequest
+	}
+}
@@ -12,8 +12,13 @@ import (
 )
 
 const (
-	StatusBadRequest uint16 = 400
-	StatusNotFound   uint16 = 404
+	StatusBadRequest          uint16 = 400
+	StatusUnauthorized        uint16 = 401
+	StatusForbidden           uint16 = 403
+	StatusNotFound            uint16 = 404
+	StatusConflict            uint16 = 409
+	StatusUnprocessableEntity uint16 = 422
+	StatusTooManyRequests     uint16 = 429
 )
 
 type RequestContext interface {
@@ -24,18 +29,17 @@ type RequestContext interface {
 	GetURL() string
 	GetID() string
 	GetPathValue(key string) string
+	GetHeader(key string) string
+	GetIdentity() Identity
+	GetBody() io.Reader
 	ParseJSONBody(object any) error
 }
 
-// ErrorResponse represents the structure of an error response.
-type ErrorResponse struct {
-	Message string `json:"message" example:"Invalid request."`
-	Error   string `json:"error" example:"details of the error"`
-}
-
 type requestContext struct {
 	responseWriter http.ResponseWriter
 	request        *http.Request
+	context        context.Context
+	identity       Identity
 	id             string
 }
 
@@ -45,7 +49,7 @@ type requestError struct {
 	err     error
 }
 
-func newRequestContext(responseWriter http.ResponseWriter, request *http.Request, idHeader string) RequestContext {
+func newRequestContext(responseWriter http.ResponseWriter, request *http.Request, idHeader string) *requestContext {
 	id := request.Header.Get(idHeader)
 
 	if id == "" {
@@ -55,12 +59,13 @@ func newRequestContext(responseWriter http.ResponseWriter, request *http.Request
 	return &requestContext{
 		responseWriter: responseWriter,
 		request:        request,
+		context:        request.Context(),
 		id:             id,
 	}
 }
 
 func (r *requestContext) Context() context.Context {
-	return r.request.Context()
+	return r.context
 }
 
 func (r *requestContext) NewError(status uint16, message string, err error) error {
@@ -91,6 +96,22 @@ func (r *requestContext) GetPathValue(key string) string {
 	return r.request.PathValue(key)
 }
 
+func (r *requestContext) GetHeader(key string) string {
+	return r.request.Header.Get(key)
+}
+
+// GetIdentity returns the caller of the request, which is empty on public
+// routes.
+func (r *requestContext) GetIdentity() Identity {
+	return r.identity
+}
+
+// GetBody returns the body of the request, for handlers that decode it as a
+// stream instead of with ParseJSONBody.
+func (r *requestContext) GetBody() io.Reader {
+	return r.request.Body
+}
+
 func (r *requestContext) ParseJSONBody(object any) error {
 	err := json.NewDecoder(r.request.Body).Decode(object)
 	if errors.Is(err, io.EOF) {
@@ -110,3 +131,7 @@ func (r *requestError) Error() string {
 
 	return r.err.Error()
 }
+
+func (r *requestError) Unwrap() error {
+	return r.err
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,7 +10,7 @@ import (
 )
 
 type Router interface {
-	AddRoute(pattern string, handler func(ctx RequestContext) (any, error))
+	AddRoute(pattern string, role Role, handler func(ctx RequestContext) (any, error))
 }
 
 type router struct {
@@ -19,17 +20,25 @@ type router struct {
 	onRequestEnd   func(ctx RequestContext, status uint16)
 	onRequestError func(ctx RequestContext, status uint16, message string, err error)
 	onRequestPanic func(ctx RequestContext, status uint16, message string, err error, stackTrace string)
+	onAuthenticate func(ctx RequestContext, credentials string) (context.Context, Identity, error)
 }
 
-func (r *router) AddRoute(pattern string, handler func(ctx RequestContext) (any, error)) {
-	r.mux.HandleFunc(pattern, r.wrapHandler(handler))
+func (r *router) AddRoute(pattern string, role Role, handler func(ctx RequestContext) (any, error)) {
+	r.mux.HandleFunc(pattern, r.wrapHandler(role, handler))
 }
 
-func (r *router) wrapHandler(handler func(ctx RequestContext) (any, error)) http.HandlerFunc {
+func (r *router) wrapHandler(role Role, handler func(ctx RequestContext) (any, error)) http.HandlerFunc {
 	return func(responseWriter http.ResponseWriter, request *http.Request) {
 		ctx := newRequestContext(responseWriter, request, r.idHeader)
 		setDefaultHeaders(responseWriter, r.idHeader, ctx)
 		defer r.recoverFromPanic(responseWriter, ctx)
+
+		if err := r.authenticate(ctx, role); err != nil {
+			r.handleError(responseWriter, ctx, err)
+
+			return
+		}
+
 		r.handleRequest(responseWriter, ctx, handler)
 	}
 }
@@ -47,10 +56,10 @@ func (r *router) recoverFromPanic(responseWriter http.ResponseWriter, ctx Reques
 		message := "An unexpected error occurred."
 		err := fmt.Errorf("%v", rec)
 
-		_ = json.NewEncoder(responseWriter).Encode(map[string]string{
-			"message": message,
-			"error":   err.Error(),
-		})
+		responseWriter.Header().Set("Content-Type", problemContentType)
+		responseWriter.WriteHeader(status)
+
+		_ = json.NewEncoder(responseWriter).Encode(newErrorResponse(uint16(status), message, err, ctx.GetURL()))
 
 		if r.onRequestPanic != nil {
 			r.onRequestPanic(ctx, uint16(status), message, err, string(debug.Stack()))
@@ -83,12 +92,10 @@ func (r *router) handleError(responseWriter http.ResponseWriter, ctx RequestCont
 		message = re.message
 	}
 
+	responseWriter.Header().Set("Content-Type", problemContentType)
 	responseWriter.WriteHeader(status)
 
-	_ = json.NewEncoder(responseWriter).Encode(map[string]string{
-		"message": message,
-		"error":   err.Error(),
-	})
+	_ = json.NewEncoder(responseWriter).Encode(newErrorResponse(uint16(status), message, err, ctx.GetURL()))
 
 	if r.onRequestError != nil {
 		r.onRequestError(ctx, uint16(status), message, err)
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"messager/domain/fault"
+)
+
+func TestRouter_HandleError(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    func(ctx RequestContext) (any, error)
+		wantStatus int
+		wantDetail string
+		wantCode   string
+		wantErrors []FieldErrorResponse
+	}{
+		{
+			name: "request error",
+			handler: func(ctx RequestContext) (any, error) {
+				return nil, ctx.NewError(StatusBadRequest, "Invalid request.",
+					fault.NewFieldError("name", fault.CodeRequired, "name must be provided", nil))
+			},
+			wantStatus: http.StatusBadRequest,
+			wantDetail: "name must be provided",
+			wantCode:   fault.CodeRequired,
+			wantErrors: []FieldErrorResponse{{Field: "name", Code: fault.CodeRequired, Detail: "name must be provided"}},
+		},
+		{
+			name: "unexpected error",
+			handler: func(ctx RequestContext) (any, error) {
+				return nil, errors.Join(fault.NewFieldError("name", fault.CodeRequired, "name must be provided", nil),
+					errors.New("dial tcp 10.0.0.1:5432: connection refused"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantDetail: "An unexpected error occurred.",
+			wantCode:   CodeInternal,
+		},
+		{
+			name: "panic",
+			handler: func(ctx RequestContext) (any, error) {
+				panic("secret stack")
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantDetail: "An unexpected error occurred.",
+			wantCode:   CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &router{mux: http.NewServeMux()}
+			r.AddRoute("GET /resource", RolePublic, tt.handler)
+
+			recorder := httptest.NewRecorder()
+			r.mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/resource", nil))
+
+			var response ErrorResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			assert.Equal(t, problemContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantDetail, response.Detail)
+			assert.Equal(t, tt.wantCode, response.Code)
+			assert.Equal(t, tt.wantErrors, response.Errors)
+		})
+	}
+}
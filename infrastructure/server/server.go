@@ -29,6 +29,10 @@ type Config struct {
 	OnRequestError  func(ctx RequestContext, status uint16, message string, err error)
 	OnRequestPanic  func(ctx RequestContext, status uint16, message string, err error, stackTrace string)
 	OnRouteNotFound func(ctx RequestContext)
+	// OnAuthenticate returns the caller of a request presenting credentials
+	// as a bearer token, and the context its handler runs in. Requests it
+	// fails for are not handled. Without it, only public routes are handled.
+	OnAuthenticate func(ctx RequestContext, credentials string) (context.Context, Identity, error)
 }
 
 type server struct {
@@ -87,6 +91,7 @@ func (s *server) NewRouter() Router {
 		onRequestEnd:   s.config.OnRequestEnd,
 		onRequestError: s.config.OnRequestError,
 		onRequestPanic: s.config.OnRequestPanic,
+		onAuthenticate: s.config.OnAuthenticate,
 	}
 }
 
@@ -5,28 +5,79 @@
 // @host localhost:2025
 // @BasePath /
 
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description An API key, as "Bearer msk_...".
+
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // time zones are embedded since the runtime image has no zoneinfo.
 
+	apikeyservice "messager/application/service/apikey"
+	campaignservice "messager/application/service/campaign"
+	consentservice "messager/application/service/consent"
 	messageservice "messager/application/service/message"
+	policyservice "messager/application/service/policy"
+	senderservice "messager/application/service/sender"
+	templateservice "messager/application/service/template"
+	tenantservice "messager/application/service/tenant"
+	"messager/domain/apikey"
+	"messager/domain/campaign"
+	"messager/domain/consent"
+	"messager/domain/message"
+	"messager/domain/policy"
+	"messager/domain/sender"
+	"messager/domain/template"
+	"messager/domain/tenant"
 	"messager/infrastructure/client"
 	"messager/infrastructure/config"
 	"messager/infrastructure/database/postgresql"
 	"messager/infrastructure/database/redis"
 	"messager/infrastructure/logger"
+	"messager/infrastructure/mailer"
+	apikeypersistence "messager/infrastructure/persistence/apikey"
+	campaignpersistence "messager/infrastructure/persistence/campaign"
+	consentpersistence "messager/infrastructure/persistence/consent"
+	"messager/infrastructure/persistence/memory"
 	messagepersistence "messager/infrastructure/persistence/message"
+	"messager/infrastructure/persistence/migration"
+	policypersistence "messager/infrastructure/persistence/policy"
+	senderpersistence "messager/infrastructure/persistence/sender"
+	templatepersistence "messager/infrastructure/persistence/template"
+	tenantpersistence "messager/infrastructure/persistence/tenant"
+	"messager/infrastructure/publisher"
+	apikeycommand "messager/presentation/command/apikey"
+	migrationcommand "messager/presentation/command/migration"
 	messageconsumer "messager/presentation/consumer/message"
+	apikeyhandler "messager/presentation/handler/apikey"
+	campaignhandler "messager/presentation/handler/campaign"
+	consenthandler "messager/presentation/handler/consent"
 	messagehandler "messager/presentation/handler/message"
+	policyhandler "messager/presentation/handler/policy"
+	senderhandler "messager/presentation/handler/sender"
+	templatehandler "messager/presentation/handler/template"
+	tenanthandler "messager/presentation/handler/tenant"
 	messagejob "messager/presentation/job/message"
 
 	"messager/infrastructure/server"
 )
 
+// roles maps the roles of api keys to the access of routes.
+var roles = map[apikey.Role]server.Role{
+	apikey.RoleReadOnly: server.RoleReadOnly,
+	apikey.RoleSender:   server.RoleSender,
+	apikey.RoleAdmin:    server.RoleAdmin,
+}
+
 func main() {
 	time.Local = time.UTC
 	logger := logger.New()
@@ -36,6 +87,279 @@ func main() {
 		logger.Fatal("failed to initialize config", err)
 	}
 
+	mode, err := config.ParseMode(cfg.GetMessager().Mode)
+	if err != nil {
+		logger.Fatal("failed to parse messager mode", err)
+	}
+
+	var (
+		store              *memory.Store
+		postgreSQL         postgresql.PostgreSQL
+		redisDatabase      redis.Redis
+		tenantRepository   tenant.Repository
+		apiKeyRepository   apikey.Repository
+		messageRepository  message.Repository
+		templateRepository template.Repository
+		campaignRepository campaign.Repository
+		consentRepository  consent.Repository
+		policyRepository   policy.Repository
+		senderRepository   sender.Repository
+	)
+
+	if mode == config.ModeEmbedded {
+		if len(os.Args) > 1 {
+			logger.Fatal("failed to run command", fmt.Errorf("the %s command needs the %s mode", os.Args[1], config.ModePostgres))
+		}
+
+		store = memory.NewStore()
+
+		tenantRepository = memory.NewTenantRepository(store)
+		apiKeyRepository = memory.NewAPIKeyRepository(store)
+	} else {
+		if cfg.GetPostgreSQL().Host == "" || cfg.GetPostgreSQL().Name == "" || cfg.GetRedis().Host == "" {
+			logger.Fatal("failed to initialize storage", errors.New("POSTGRESQL_* and REDIS_* must be set unless MESSAGER_MODE is embedded"))
+		}
+
+		migrationMode, err := migration.ParseMode(cfg.GetPostgreSQL().MigrationMode)
+		if err != nil {
+			logger.Fatal("failed to parse migration mode", err)
+		}
+
+		postgreSQL, err = postgresql.New(postgresql.Config{
+			Host:     cfg.GetPostgreSQL().Host,
+			Port:     cfg.GetPostgreSQL().Port,
+			User:     cfg.GetPostgreSQL().User,
+			Password: cfg.GetPostgreSQL().Password,
+			Name:     cfg.GetPostgreSQL().Name,
+		})
+		if err != nil {
+			logger.Fatal("failed to initialize postgresql", err)
+		}
+
+		migrator, err := migration.New(postgreSQL)
+		if err != nil {
+			logger.Fatal("failed to initialize migrator", err)
+		}
+
+		if len(os.Args) > 1 && os.Args[1] == "migrate" {
+			err := migrationcommand.New(migrator, os.Stdout).Run(context.Background(), os.Args[2:])
+			postgreSQL.Close()
+
+			if err != nil {
+				logger.Fatal("migrate command failed", err)
+			}
+
+			return
+		}
+
+		if migrationMode == migration.ModeVerify {
+			if err := migrator.Verify(context.Background()); err != nil {
+				logger.Fatal("failed to verify schema", err)
+			}
+		} else {
+			applied, err := migrator.Up(context.Background())
+			if err != nil {
+				logger.Fatal("failed to migrate schema", err)
+			}
+
+			for _, appliedMigration := range applied {
+				logger.Debug("migration applied", "version", appliedMigration.Version, "name", appliedMigration.Name)
+			}
+		}
+
+		tenantRepository = tenantpersistence.New(postgreSQL)
+		apiKeyRepository = apikeypersistence.New(postgreSQL)
+	}
+
+	apiKeyService := apikeyservice.New(apiKeyRepository, tenantRepository)
+
+	if len(os.Args) > 1 && os.Args[1] == "api-key" {
+		err := apikeycommand.New(apiKeyService, os.Stdout).Run(context.Background(), os.Args[2:])
+		postgreSQL.Close()
+
+		if err != nil {
+			logger.Fatal("api key command failed", err)
+		}
+
+		return
+	}
+
+	if mode == config.ModeEmbedded {
+		redisDatabase = redis.NewMemory()
+
+		messageRepository = memory.NewMessageRepository(store, redisDatabase)
+		templateRepository = memory.NewTemplateRepository(store)
+		campaignRepository = memory.NewCampaignRepository(store)
+		consentRepository = memory.NewConsentRepository(store)
+		policyRepository = memory.NewPolicyRepository(store)
+		senderRepository = memory.NewSenderRepository(store)
+	} else {
+		redisDatabase, err = redis.New(redis.Config{
+			Host:     cfg.GetRedis().Host,
+			Port:     cfg.GetRedis().Port,
+			User:     cfg.GetRedis().User,
+			Password: cfg.GetRedis().Password,
+			DB:       cfg.GetRedis().DB,
+		})
+		if err != nil {
+			logger.Fatal("failed to initialize redis", err)
+		}
+
+		messageRepository = messagepersistence.New(postgreSQL, redisDatabase, messagepersistence.Config{
+			AttemptCacheTTL: cfg.GetMessage().AttemptCacheTTL,
+			OnCacheError: func(err error) {
+				logger.Warning("message attempt cache failed", err)
+			},
+		})
+		templateRepository = templatepersistence.New(postgreSQL)
+		campaignRepository = campaignpersistence.New(postgreSQL)
+		consentRepository = consentpersistence.New(postgreSQL)
+		policyRepository = policypersistence.New(postgreSQL)
+		senderRepository = senderpersistence.New(postgreSQL)
+	}
+
+	clients := map[message.Channel]client.Client{
+		message.ChannelSMS: client.New(client.Config{
+			URL:     cfg.GetClient().URL,
+			Token:   cfg.GetClient().Token,
+			Timeout: cfg.GetClient().Timeout,
+		}),
+	}
+
+	if cfg.GetSMTP().Host != "" {
+		clients[message.ChannelEmail] = mailer.New(mailer.Config{
+			Host:          cfg.GetSMTP().Host,
+			Port:          cfg.GetSMTP().Port,
+			Username:      cfg.GetSMTP().Username,
+			Password:      cfg.GetSMTP().Password,
+			From:          cfg.GetSMTP().From,
+			RequireTLS:    cfg.GetSMTP().RequireTLS,
+			TLSSkipVerify: cfg.GetSMTP().TLSSkipVerify,
+			Timeout:       cfg.GetSMTP().Timeout,
+		})
+	}
+
+	quietHours, err := message.ParseQuietHoursByCategory(cfg.GetMessage().QuietHours, map[message.Category]string{
+		message.CategoryTransactional: cfg.GetMessage().TransactionalQuietHours,
+		message.CategoryMarketing:     cfg.GetMessage().MarketingQuietHours,
+	})
+	if err != nil {
+		logger.Fatal("failed to parse quiet hours", err)
+	}
+
+	senderStrategy, err := sender.ParseStrategy(cfg.GetSender().Strategy)
+	if err != nil {
+		logger.Fatal("failed to parse sender strategy", err)
+	}
+
+	outboxRelay, err := message.ParseRelay(cfg.GetOutbox().Relay)
+	if err != nil {
+		logger.Fatal("failed to parse outbox relay", err)
+	}
+
+	if mode == config.ModeEmbedded {
+		// The embedded mode runs without Kafka, the outbox is dispatched in
+		// process.
+		outboxRelay = message.RelayDirect
+	}
+
+	var outboxPublisher message.Publisher
+
+	if outboxRelay == message.RelayKafka {
+		if len(cfg.GetKafka().Brokers) == 0 || cfg.GetKafka().Topic == "" || cfg.GetKafka().GroupID == "" {
+			logger.Fatal("failed to initialize outbox publisher", errors.New("KAFKA_BROKERS, KAFKA_TOPIC and KAFKA_GROUP_ID must be set to relay to kafka"))
+		}
+
+		outboxPublisher = publisher.New(publisher.Config{
+			Brokers: cfg.GetKafka().Brokers,
+			Topic:   cfg.GetKafka().Topic,
+		})
+	}
+
+	var contentPolicies []policy.Policy
+
+	contentPolicy := policy.Policy{
+		Name:           "config",
+		ForbiddenWords: cfg.GetContentPolicy().ForbiddenWords,
+		AllowedDomains: cfg.GetContentPolicy().AllowedDomains,
+		RequiredFooter: cfg.GetContentPolicy().RequiredFooter,
+		MaxLinks:       cfg.GetContentPolicy().MaxLinks,
+	}
+
+	if cfg.GetContentPolicy().ForbiddenPattern != "" {
+		contentPolicy.ForbiddenPatterns = []string{cfg.GetContentPolicy().ForbiddenPattern}
+	}
+
+	if !contentPolicy.IsEmpty() {
+		if err := contentPolicy.ValidateRules(); err != nil {
+			logger.Fatal("failed to validate content policy", err)
+		}
+
+		contentPolicy.Normalize()
+		contentPolicies = append(contentPolicies, contentPolicy)
+	}
+
+	messageService := messageservice.New(
+		messageRepository, templateRepository, consentRepository, policyRepository, senderRepository, clients,
+		messageservice.Config{
+			BatchSizes: map[message.Priority]int{
+				message.PriorityTransactional: cfg.GetJob().TransactionalBatchSize,
+				message.PriorityNormal:        cfg.GetJob().NormalBatchSize,
+				message.PriorityBulk:          cfg.GetJob().BulkBatchSize,
+			},
+			CreateOptions: message.CreateOptions{
+				MaxSegments:   cfg.GetMessage().MaxSegments,
+				DefaultRegion: cfg.GetMessage().DefaultRegion,
+			},
+			IdempotencyTTL:  cfg.GetMessage().IdempotencyTTL,
+			MaxBatchSize:    cfg.GetMessage().MaxBatchSize,
+			QuietHours:      quietHours,
+			ContentPolicies: contentPolicies,
+			SenderStrategy:  senderStrategy,
+			Publisher:       outboxPublisher,
+			OutboxBatchSize: cfg.GetOutbox().BatchSize,
+			ClaimTimeout:    cfg.GetJob().ClaimTimeout,
+			OnError: func(err error) {
+				logger.Error("message service failed", err)
+			},
+		},
+	)
+
+	templateService := templateservice.New(templateRepository, templateservice.Config{
+		DefaultRegion: cfg.GetMessage().DefaultRegion,
+	})
+
+	policyService := policyservice.New(policyRepository)
+
+	senderService := senderservice.New(senderRepository, senderservice.Config{
+		DefaultRegion: cfg.GetMessage().DefaultRegion,
+	})
+
+	consentService := consentservice.New(consentRepository, consentservice.Config{
+		DefaultRegion: cfg.GetMessage().DefaultRegion,
+	})
+
+	tenantService := tenantservice.New(tenantRepository)
+
+	campaignService := campaignservice.New(campaignRepository, messageService, campaignservice.Config{
+		MaxRecipients: cfg.GetCampaign().MaxRecipients,
+		BatchSize:     cfg.GetMessage().MaxBatchSize,
+	})
+
+	if mode == config.ModeEmbedded {
+		// The store starts empty and is lost on stop, so the first ADMIN key
+		// is created on every start rather than with the api-key command.
+		key, err := apiKeyService.Create(tenant.NewContext(context.Background(), tenant.Default()), apikey.APIKey{
+			Name: "embedded",
+			Role: apikey.RoleAdmin,
+		})
+		if err != nil {
+			logger.Fatal("failed to create embedded api key", err)
+		}
+
+		logger.Info("embedded api key created, it is not shown again", "key", key.Secret)
+	}
+
 	srv := server.New(server.Config{
 		Host:     cfg.GetServer().Host,
 		Port:     cfg.GetServer().Port,
@@ -55,69 +379,77 @@ func main() {
 		OnRequestPanic: func(ctx server.RequestContext, status uint16, message string, err error, stackTrace string) {
 			logger.FatalWithoutExit("request panicked", err, "id", ctx.GetID(), "status", status, "cause", message, "stacktrace", stackTrace)
 		},
+		OnAuthenticate: func(ctx server.RequestContext, credentials string) (context.Context, server.Identity, error) {
+			key, err := apiKeyService.Authenticate(ctx.Context(), credentials)
+			if errors.Is(err, apikey.ErrAPIKeyUnauthenticated) {
+				return nil, server.Identity{}, ctx.NewError(server.StatusUnauthorized, "Unauthorized.", err)
+			}
+			if err != nil {
+				return nil, server.Identity{}, fmt.Errorf("apiKeyService.Authenticate(): %w", err)
+			}
+
+			current, err := tenantService.Resolve(ctx.Context(), key.TenantID)
+			if errors.Is(err, tenant.ErrTenantNotFound) {
+				return nil, server.Identity{}, ctx.NewError(server.StatusUnauthorized, "Unauthorized.", errors.Join(apikey.ErrAPIKeyUnauthenticated, err))
+			}
+			if err != nil {
+				return nil, server.Identity{}, fmt.Errorf("tenantService.Resolve(): %w", err)
+			}
+
+			return tenant.NewContext(ctx.Context(), *current), server.Identity{
+				KeyID:    key.ID,
+				Name:     key.Name,
+				TenantID: key.TenantID,
+				Role:     roles[key.Role],
+			}, nil
+		},
 	})
 
 	router := srv.NewRouter()
 
-	router.AddRoute("GET /health", func(ctx server.RequestContext) (any, error) {
+	router.AddRoute("GET /health", server.RolePublic, func(ctx server.RequestContext) (any, error) {
 		return map[string]string{
 			"status": "green",
 		}, nil
 	})
 
-	postgreSQL, err := postgresql.New(postgresql.Config{
-		Host:     cfg.GetPostgreSQL().Host,
-		Port:     cfg.GetPostgreSQL().Port,
-		User:     cfg.GetPostgreSQL().User,
-		Password: cfg.GetPostgreSQL().Password,
-		Name:     cfg.GetPostgreSQL().Name,
+	messageJob := messagejob.New(messageService, cfg.GetJob().Interval, func(err error) {
+		logger.FatalWithoutExit("message job failed", err)
 	})
-	if err != nil {
-		logger.Fatal("failed to initialize postgresql", err)
-	}
 
-	redis, err := redis.New(redis.Config{
-		Host:     cfg.GetRedis().Host,
-		Port:     cfg.GetRedis().Port,
-		User:     cfg.GetRedis().User,
-		Password: cfg.GetRedis().Password,
-		DB:       cfg.GetRedis().DB,
+	messageExpirationJob := messagejob.NewExpiration(messageService, cfg.GetJob().ExpirationInterval, func(err error) {
+		logger.FatalWithoutExit("message expiration job failed", err)
 	})
-	if err != nil {
-		logger.Fatal("failed to initialize redis", err)
-	}
 
-	messageRepository, err := messagepersistence.New(postgreSQL, redis)
-	if err != nil {
-		logger.Fatal("failed to initialize message repository", err)
-	}
-
-	client := client.New(client.Config{
-		URL:     cfg.GetClient().URL,
-		Token:   cfg.GetClient().Token,
-		Timeout: cfg.GetClient().Timeout,
+	messageRelayJob := messagejob.NewRelay(messageService, cfg.GetOutbox().Interval, func(err error) {
+		logger.FatalWithoutExit("message relay job failed", err)
 	})
 
-	messageService := messageservice.New(messageRepository, client)
+	var messageConsumer messageconsumer.Consumer
 
-	messageJob := messagejob.New(messageService, cfg.GetJob().Interval, func(err error) {
-		logger.FatalWithoutExit("message job failed", err)
-	})
-
-	messageConsumer, err := messageconsumer.New(
-		messageService,
-		cfg.GetKafka().Brokers,
-		cfg.GetKafka().GroupID,
-		cfg.GetKafka().Topic,
-		func(err error) {
-			logger.FatalWithoutExit("message consume failed", err)
-		},
-	)
-	if err != nil {
-		logger.Fatal("failed to initialize message consumer", err)
+	if outboxRelay == message.RelayKafka {
+		messageConsumer, err = messageconsumer.New(
+			messageService,
+			cfg.GetKafka().Brokers,
+			cfg.GetKafka().GroupID,
+			cfg.GetKafka().Topic,
+			func(err error) {
+				logger.FatalWithoutExit("message consume failed", err)
+			},
+		)
+		if err != nil {
+			logger.Fatal("failed to initialize message consumer", err)
+		}
 	}
 
-	_ = messagehandler.New(router, messageService, messageJob)
+	_ = messagehandler.New(router, messageService, tenantService, messageJob, cfg.GetMessage().MaxBatchSize)
+	_ = templatehandler.New(router, templateService)
+	_ = campaignhandler.New(router, campaignService)
+	_ = consenthandler.New(router, consentService)
+	_ = policyhandler.New(router, policyService)
+	_ = senderhandler.New(router, senderService)
+	_ = tenanthandler.New(router, tenantService)
+	_ = apikeyhandler.New(router, apiKeyService)
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
@@ -129,9 +461,14 @@ func main() {
 		}
 	}()
 
-	go func() {
-		messageConsumer.Start()
-	}()
+	if messageConsumer != nil {
+		go func() {
+			messageConsumer.Start()
+		}()
+	}
+
+	messageRelayJob.Start()
+	messageExpirationJob.Start()
 
 	<-stop
 
@@ -139,14 +476,26 @@ func main() {
 		logger.FatalWithoutExit("failed to stop server", err)
 	}
 
-	if err := messageConsumer.Stop(); err != nil {
-		logger.FatalWithoutExit("failed to stop message consumer", err)
+	messageJob.Stop()
+	messageExpirationJob.Stop()
+	messageRelayJob.Stop()
+
+	if outboxPublisher != nil {
+		if err := outboxPublisher.Close(); err != nil {
+			logger.FatalWithoutExit("failed to stop outbox publisher", err)
+		}
 	}
 
-	messageJob.Stop()
-	postgreSQL.Close()
+	if messageConsumer != nil {
+		if err := messageConsumer.Stop(); err != nil {
+			logger.FatalWithoutExit("failed to stop message consumer", err)
+		}
+	}
+	if postgreSQL != nil {
+		postgreSQL.Close()
+	}
 
-	if err := redis.Close(); err != nil {
+	if err := redisDatabase.Close(); err != nil {
 		logger.FatalWithoutExit("failed to stop redis", err)
 	}
 
@@ -0,0 +1,149 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"messager/domain/apikey"
+	"messager/domain/tenant"
+)
+
+const usage = `usage:
+  messager api-key create -name NAME -role READ_ONLY|SENDER|ADMIN [-tenant ID]
+  messager api-key list [-tenant ID]
+  messager api-key revoke [-tenant ID] ID`
+
+var errUsage = errors.New(usage)
+
+// Command manages API keys from the command line, on behalf of the default
+// tenant. It is how the first ADMIN key is created.
+type Command interface {
+	Run(ctx context.Context, args []string) error
+}
+
+type command struct {
+	service apikey.Service
+	out     io.Writer
+}
+
+func New(service apikey.Service, out io.Writer) Command {
+	return &command{
+		service: service,
+		out:     out,
+	}
+}
+
+// Run runs the subcommand args name with the rest of args as its flags.
+func (c *command) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	ctx = tenant.NewContext(ctx, tenant.Default())
+
+	switch args[0] {
+	case "create":
+		return c.create(ctx, args[1:])
+	case "list":
+		return c.list(ctx, args[1:])
+	case "revoke":
+		return c.revoke(ctx, args[1:])
+	default:
+		return errUsage
+	}
+}
+
+func (c *command) create(ctx context.Context, args []string) error {
+	flags := newFlagSet("create")
+	name := flags.String("name", "", "name of the key")
+	role := flags.String("role", "", "role of the key: READ_ONLY, SENDER or ADMIN")
+	tenantID := flags.String("tenant", "", "tenant of the key, the default tenant when omitted")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.Join(errUsage, err)
+	}
+
+	newKey, err := c.service.Create(ctx, apikey.APIKey{
+		TenantID: *tenantID,
+		Name:     *name,
+		Role:     apikey.Role(*role),
+	})
+	if err != nil {
+		return fmt.Errorf("command.service.Create(): %w", err)
+	}
+
+	fmt.Fprintf(c.out, "id:     %s\ntenant: %s\nrole:   %s\nkey:    %s\n", newKey.ID, newKey.TenantID, newKey.Role, newKey.Secret)
+	fmt.Fprintln(c.out, "The key is not shown again, store it now.")
+
+	return nil
+}
+
+func (c *command) list(ctx context.Context, args []string) error {
+	flags := newFlagSet("list")
+	tenantID := flags.String("tenant", "", "tenant of the keys, the default tenant when omitted")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.Join(errUsage, err)
+	}
+
+	keys, err := c.service.List(tenantContext(ctx, *tenantID))
+	if err != nil {
+		return fmt.Errorf("command.service.List(): %w", err)
+	}
+
+	writer := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tNAME\tROLE\tPREFIX\tCREATED AT")
+
+	for _, key := range keys {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", key.ID, key.Name, key.Role, key.Prefix, key.CreatedAt.Format(time.RFC3339))
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("tabwriter.Writer.Flush(): %w", err)
+	}
+
+	return nil
+}
+
+func (c *command) revoke(ctx context.Context, args []string) error {
+	flags := newFlagSet("revoke")
+	tenantID := flags.String("tenant", "", "tenant of the key, the default tenant when omitted")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.Join(errUsage, err)
+	}
+
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	if err := c.service.Delete(tenantContext(ctx, *tenantID), flags.Arg(0)); err != nil {
+		return fmt.Errorf("command.service.Delete(): %w", err)
+	}
+
+	fmt.Fprintf(c.out, "revoked %s\n", flags.Arg(0))
+
+	return nil
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	return flags
+}
+
+// tenantContext returns ctx acting for the tenant id, or ctx itself, which
+// belongs to the default tenant, when id is empty.
+func tenantContext(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+
+	return tenant.NewContext(ctx, tenant.Tenant{ID: id})
+}
@@ -0,0 +1,116 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"messager/infrastructure/persistence/migration"
+)
+
+const usage = `usage:
+  messager migrate up
+  messager migrate down
+  messager migrate status`
+
+var errUsage = errors.New(usage)
+
+// Command applies and reverts the migrations of the schema from the command
+// line.
+type Command interface {
+	Run(ctx context.Context, args []string) error
+}
+
+type command struct {
+	migrator migration.Migrator
+	out      io.Writer
+}
+
+func New(migrator migration.Migrator, out io.Writer) Command {
+	return &command{
+		migrator: migrator,
+		out:      out,
+	}
+}
+
+// Run runs the subcommand args name.
+func (c *command) Run(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "up":
+		return c.up(ctx)
+	case "down":
+		return c.down(ctx)
+	case "status":
+		return c.status(ctx)
+	default:
+		return errUsage
+	}
+}
+
+func (c *command) up(ctx context.Context) error {
+	applied, err := c.migrator.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("command.migrator.Up(): %w", err)
+	}
+
+	if len(applied) == 0 {
+		fmt.Fprintln(c.out, "schema is up to date")
+
+		return nil
+	}
+
+	for _, migration := range applied {
+		fmt.Fprintf(c.out, "applied %04d_%s\n", migration.Version, migration.Name)
+	}
+
+	return nil
+}
+
+func (c *command) down(ctx context.Context) error {
+	reverted, err := c.migrator.Down(ctx)
+	if err != nil {
+		return fmt.Errorf("command.migrator.Down(): %w", err)
+	}
+
+	if reverted == nil {
+		fmt.Fprintln(c.out, "no migration is applied")
+
+		return nil
+	}
+
+	fmt.Fprintf(c.out, "reverted %04d_%s\n", reverted.Version, reverted.Name)
+
+	return nil
+}
+
+func (c *command) status(ctx context.Context) error {
+	statuses, err := c.migrator.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("command.migrator.Status(): %w", err)
+	}
+
+	writer := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.Applied {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(writer, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("tabwriter.Writer.Flush(): %w", err)
+	}
+
+	return nil
+}
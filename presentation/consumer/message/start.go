@@ -44,12 +44,10 @@ func (c *consumer) Start() {
 		}
 
 		var value struct {
-			Before struct {
-				Status string `json:"status"`
-			} `json:"before"`
-			After struct {
-				Status string `json:"status"`
-			} `json:"after"`
+			ID       int64  `json:"id"`
+			TenantID string `json:"tenantId"`
+			From     string `json:"from"`
+			To       string `json:"to"`
 		}
 
 		if err = json.Unmarshal(event.Value, &value); err != nil {
@@ -59,24 +57,16 @@ func (c *consumer) Start() {
 			continue
 		}
 
-		if value.Before.Status != string(message.StatusPending) {
-			c.onError(fmt.Errorf("message %s before status is not PENDING", key.ID))
-			c.wg.Done()
-
-			continue
-		}
-
-		if value.After.Status != string(message.StatusSent) {
-			c.onError(fmt.Errorf("message %s after status is not SENT", key.ID))
-			c.wg.Done()
-
-			continue
-		}
-
-		if err = c.service.Sent(context.Background(), message.Message{
-			ID: key.ID,
-		}); err != nil {
-			c.onError(fmt.Errorf("consumer.service.Sent: %w", err))
+		// Entries are delivered at least once. A duplicate finds its message
+		// out of SENDING and is rejected by Sent.
+		if err = c.service.Dispatch(context.Background(), []message.OutboxEntry{{
+			ID:        value.ID,
+			MessageID: key.ID,
+			TenantID:  value.TenantID,
+			From:      message.Status(value.From),
+			To:        message.Status(value.To),
+		}}); err != nil {
+			c.onError(fmt.Errorf("consumer.service.Dispatch: %w", err))
 		}
 
 		c.wg.Done()
@@ -0,0 +1,48 @@
+package apikey
+
+import (
+	"time"
+
+	"messager/domain/apikey"
+)
+
+type apiKeyRequest struct {
+	Name     string `json:"name" example:"checkout"`
+	Role     string `json:"role" example:"SENDER"`
+	TenantID string `json:"tenantId,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+}
+
+type apiKeyResponse struct {
+	ID        string `json:"id" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	TenantID  string `json:"tenantId" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt string `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Name      string `json:"name" example:"checkout"`
+	Role      string `json:"role" example:"SENDER"`
+	Prefix    string `json:"prefix" example:"msk_Zm9vYmFy"`
+	Key       string `json:"key,omitempty" example:"msk_Zm9vYmFyYmF6cXV4Zm9vYmFyYmF6cXV4Zm9vYmFyYmE"`
+}
+
+func (r *apiKeyRequest) toAPIKey() apikey.APIKey {
+	return apikey.APIKey{
+		TenantID: r.TenantID,
+		Name:     r.Name,
+		Role:     apikey.Role(r.Role),
+	}
+}
+
+func apiKeyToAPIKeyResponse(key apikey.APIKey) *apiKeyResponse {
+	response := apiKeyResponse{
+		ID:       key.ID,
+		TenantID: key.TenantID,
+		Name:     key.Name,
+		Role:     string(key.Role),
+		Prefix:   key.Prefix,
+		Key:      key.Secret,
+	}
+
+	if !key.CreatedAt.IsZero() {
+		response.CreatedAt = key.CreatedAt.Format(time.RFC3339)
+	}
+
+	return &response
+}
@@ -0,0 +1,48 @@
+package apikey
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/apikey"
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+// @Summary Create an API key
+// @Description Create a key for the caller's tenant with one of the roles READ_ONLY, SENDER or ADMIN. The default tenant
+// @Description may create keys for other tenants with tenantId. key is only returned here; only its hash is stored.
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param key body apiKeyRequest true "API key to be created"
+// @Success 201 {object} apiKeyResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 403 {object} server.ErrorResponse "Tenant is not allowed to create keys for other tenants"
+// @Failure 404 {object} server.ErrorResponse "Tenant not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /api-keys [post]
+func (h *handler) create(ctx server.RequestContext) (any, error) {
+	var request apiKeyRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	newKey, err := h.service.Create(ctx.Context(), request.toAPIKey())
+	if errors.Is(err, apikey.ErrAPIKeyDoesNotValidForCreate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantForbidden) {
+		return nil, ctx.NewError(server.StatusForbidden, "Forbidden.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Tenant not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Create(): %w", err)
+	}
+
+	return apiKeyToAPIKeyResponse(*newKey), nil
+}
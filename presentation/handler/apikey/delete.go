@@ -0,0 +1,49 @@
+package apikey
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/apikey"
+	"messager/infrastructure/server"
+)
+
+type deleteRequest struct {
+	id string
+}
+
+type deleteResponse struct {
+	Deleted bool `json:"deleted" example:"true"`
+}
+
+// @Summary Revoke an API key
+// @Description Delete a key of the caller's tenant by ID. Requests with it are refused from then on.
+// @Tags api-keys
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} deleteResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid API key ID"
+// @Failure 404 {object} server.ErrorResponse "API key not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /api-keys/{id} [delete]
+func (h *handler) delete(ctx server.RequestContext) (any, error) {
+	request := deleteRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	err := h.service.Delete(ctx.Context(), request.id)
+	if errors.Is(err, apikey.ErrAPIKeyDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, apikey.ErrAPIKeyNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "API key not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Delete(): %w", err)
+	}
+
+	return deleteResponse{
+		Deleted: true,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package apikey
+
+import (
+	"messager/domain/apikey"
+	service "messager/domain/apikey"
+	"messager/infrastructure/server"
+)
+
+type Handler interface {
+	create(ctx server.RequestContext) (any, error)
+}
+
+type handler struct {
+	service service.Service
+}
+
+func New(router server.Router, service apikey.Service) Handler {
+	h := handler{
+		service: service,
+	}
+
+	router.AddRoute("POST /api-keys", server.RoleAdmin, h.create)
+	router.AddRoute("GET /api-keys", server.RoleAdmin, h.list)
+	router.AddRoute("DELETE /api-keys/{id}", server.RoleAdmin, h.delete)
+
+	return &h
+}
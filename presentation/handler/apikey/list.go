@@ -0,0 +1,41 @@
+package apikey
+
+import (
+	"fmt"
+
+	"messager/domain/apikey"
+	"messager/infrastructure/server"
+)
+
+type listResponse struct {
+	Items []apiKeyResponse `json:"items"`
+}
+
+// @Summary List API keys
+// @Description Get the keys of the caller's tenant. Their secrets are not returned.
+// @Tags api-keys
+// @Produce json
+// @Success 200 {object} listResponse
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /api-keys [get]
+func (h *handler) list(ctx server.RequestContext) (any, error) {
+	keys, err := h.service.List(ctx.Context())
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.List(): %w", err)
+	}
+
+	return apiKeysToListResponse(keys), nil
+}
+
+func apiKeysToListResponse(keys []apikey.APIKey) *listResponse {
+	response := listResponse{
+		Items: make([]apiKeyResponse, 0),
+	}
+
+	for _, key := range keys {
+		response.Items = append(response.Items, *apiKeyToAPIKeyResponse(key))
+	}
+
+	return &response
+}
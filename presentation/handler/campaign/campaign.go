@@ -0,0 +1,107 @@
+package campaign
+
+import (
+	"time"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+)
+
+const localTimeLayout = "2006-01-02T15:04:05"
+
+type campaignResponse struct {
+	ID             string              `json:"id" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt      string              `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	UpdatedAt      string              `json:"updatedAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Name           string              `json:"name" example:"Black Friday"`
+	Status         string              `json:"status" example:"DRAFT"`
+	Content        string              `json:"content,omitempty" example:"Everything is 50% off today!"`
+	TemplateID     string              `json:"templateId,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	Locale         string              `json:"locale,omitempty" example:"tr"`
+	SendAt         string              `json:"sendAt,omitempty" example:"2025-01-01T09:00:00"`
+	TimeZone       string              `json:"timeZone,omitempty" example:"recipient"`
+	ValidUntil     string              `json:"validUntil,omitempty" example:"2025-01-01T21:00:00Z"`
+	Priority       string              `json:"priority" example:"BULK"`
+	Category       string              `json:"category" example:"MARKETING"`
+	RecipientCount int                 `json:"recipientCount" example:"2"`
+	Rejections     []rejectionResponse `json:"rejections,omitempty"`
+	Progress       *progressResponse   `json:"progress,omitempty"`
+}
+
+type rejectionResponse struct {
+	Index int    `json:"index" example:"1"`
+	Phone string `json:"phone" example:"+90555"`
+	Error string `json:"error" example:"message does not valid for create\nmessage phone must be a valid phone number"`
+}
+
+type progressResponse struct {
+	Pending   int `json:"pending" example:"10"`
+	Queued    int `json:"queued" example:"0"`
+	Sending   int `json:"sending" example:"5"`
+	Sent      int `json:"sent" example:"80"`
+	Delivered int `json:"delivered" example:"0"`
+	Failed    int `json:"failed" example:"3"`
+	Cancelled int `json:"cancelled" example:"0"`
+	Expired   int `json:"expired" example:"2"`
+	Total     int `json:"total" example:"100"`
+}
+
+func campaignToCampaignResponse(campaign campaign.Campaign) *campaignResponse {
+	response := campaignResponse{
+		ID:             campaign.ID,
+		Name:           campaign.Name,
+		Status:         string(campaign.Status),
+		Content:        campaign.Content,
+		TemplateID:     campaign.TemplateID,
+		Locale:         campaign.Locale,
+		TimeZone:       campaign.TimeZone,
+		Priority:       string(campaign.Priority),
+		Category:       string(campaign.Category),
+		RecipientCount: campaign.RecipientCount,
+	}
+
+	if !campaign.CreatedAt.IsZero() {
+		response.CreatedAt = campaign.CreatedAt.Format(time.RFC3339)
+	}
+
+	if !campaign.UpdatedAt.IsZero() {
+		response.UpdatedAt = campaign.UpdatedAt.Format(time.RFC3339)
+	}
+
+	if !campaign.SendAt.IsZero() {
+		layout := time.RFC3339
+		if campaign.TimeZone != "" {
+			layout = localTimeLayout
+		}
+
+		response.SendAt = campaign.SendAt.Format(layout)
+	}
+
+	if !campaign.ValidUntil.IsZero() {
+		response.ValidUntil = campaign.ValidUntil.Format(time.RFC3339)
+	}
+
+	for _, rejection := range campaign.Rejections {
+		response.Rejections = append(response.Rejections, rejectionResponse{
+			Index: rejection.Index,
+			Phone: rejection.Phone,
+			Error: rejection.Err.Error(),
+		})
+	}
+
+	if campaign.Progress != nil {
+		response.Progress = &progressResponse{
+			Pending:   campaign.Progress[message.StatusPending],
+			Queued:    campaign.Progress[message.StatusQueued],
+			Sending:   campaign.Progress[message.StatusSending],
+			Sent:      campaign.Progress[message.StatusSent],
+			Delivered: campaign.Progress[message.StatusDelivered],
+			Failed:    campaign.Progress[message.StatusFailed],
+			Cancelled: campaign.Progress[message.StatusCancelled],
+			Expired:   campaign.Progress[message.StatusExpired],
+			Total:     campaign.Progress.Total(),
+		}
+	}
+
+	return &response
+}
@@ -0,0 +1,119 @@
+package campaign
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"messager/domain/campaign"
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+type createRequest struct {
+	Name       string             `json:"name" example:"Black Friday"`
+	Content    string             `json:"content,omitempty" example:"Everything is 50% off today!"`
+	TemplateID string             `json:"templateId,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	Locale     string             `json:"locale,omitempty" example:"tr"`
+	Variables  map[string]string  `json:"variables,omitempty"`
+	SendAt     string             `json:"sendAt,omitempty" example:"2025-01-01T09:00:00"`
+	TimeZone   string             `json:"timeZone,omitempty" example:"recipient"`
+	ValidUntil string             `json:"validUntil,omitempty" example:"2025-01-01T21:00:00Z"`
+	Priority   string             `json:"priority,omitempty" example:"BULK"`
+	Category   string             `json:"category,omitempty" example:"MARKETING"`
+	Recipients []recipientRequest `json:"recipients"`
+}
+
+type recipientRequest struct {
+	Phone     string            `json:"phone" example:"+905551234567"`
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// @Summary Create a new campaign
+// @Description Create a draft campaign that sends one content or template to many recipients. A message is created for
+// @Description every valid recipient and dispatched once the campaign is started. Invalid recipients are reported as
+// @Description rejections. sendAt, timeZone, validUntil, priority (default BULK) and category (default MARKETING) apply to every message as in
+// @Description POST /messages. Recipient variables override the campaign variables. The messages count against the daily
+// @Description quota of the caller's tenant.
+// @Tags campaigns
+// @Accept json
+// @Produce json
+// @Param campaign body createRequest true "Campaign object to be created"
+// @Success 201 {object} campaignResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 429 {object} server.ErrorResponse "Tenant daily quota exceeded"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /campaigns [post]
+func (h *handler) create(ctx server.RequestContext) (any, error) {
+	var request createRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	requestCampaign, err := request.toCampaign()
+	if err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	newCampaign, err := h.service.Create(ctx.Context(), requestCampaign)
+	if errors.Is(err, campaign.ErrCampaignDoesNotValidForCreate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantQuotaExceeded) {
+		return nil, ctx.NewError(server.StatusTooManyRequests, "Daily quota exceeded.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Create(): %w", err)
+	}
+
+	return campaignToCampaignResponse(*newCampaign), nil
+}
+
+func (r *createRequest) toCampaign() (campaign.Campaign, error) {
+	newCampaign := campaign.Campaign{
+		Name:       r.Name,
+		Content:    r.Content,
+		TemplateID: r.TemplateID,
+		Locale:     r.Locale,
+		Variables:  r.Variables,
+		TimeZone:   r.TimeZone,
+		Priority:   message.Priority(r.Priority),
+		Category:   message.Category(r.Category),
+		Recipients: make([]campaign.Recipient, 0, len(r.Recipients)),
+	}
+
+	if r.SendAt != "" {
+		layout := time.RFC3339
+		if r.TimeZone != "" {
+			layout = localTimeLayout
+		}
+
+		sendAt, err := time.Parse(layout, r.SendAt)
+		if err != nil {
+			return campaign.Campaign{}, fmt.Errorf("time.Parse(): %w", err)
+		}
+
+		newCampaign.SendAt = sendAt
+	}
+
+	if r.ValidUntil != "" {
+		validUntil, err := time.Parse(time.RFC3339, r.ValidUntil)
+		if err != nil {
+			return campaign.Campaign{}, fmt.Errorf("time.Parse(): %w", err)
+		}
+
+		newCampaign.ValidUntil = validUntil
+	}
+
+	for _, recipient := range r.Recipients {
+		newCampaign.Recipients = append(newCampaign.Recipients, campaign.Recipient{
+			Phone:     recipient.Phone,
+			Variables: recipient.Variables,
+		})
+	}
+
+	return newCampaign, nil
+}
@@ -0,0 +1,43 @@
+package campaign
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/infrastructure/server"
+)
+
+type getRequest struct {
+	id string
+}
+
+// @Summary Get a campaign
+// @Description Get a campaign by ID with the number of its messages in every status
+// @Tags campaigns
+// @Produce json
+// @Param id path string true "Campaign ID"
+// @Success 200 {object} campaignResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid campaign ID"
+// @Failure 404 {object} server.ErrorResponse "Campaign not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /campaigns/{id} [get]
+func (h *handler) get(ctx server.RequestContext) (any, error) {
+	request := getRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	foundCampaign, err := h.service.Get(ctx.Context(), request.id)
+	if errors.Is(err, campaign.ErrCampaignDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, campaign.ErrCampaignNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Campaign not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Get(): %w", err)
+	}
+
+	return campaignToCampaignResponse(*foundCampaign), nil
+}
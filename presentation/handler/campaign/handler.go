@@ -0,0 +1,30 @@
+package campaign
+
+import (
+	"messager/domain/campaign"
+	service "messager/domain/campaign"
+	"messager/infrastructure/server"
+)
+
+type Handler interface {
+	create(ctx server.RequestContext) (any, error)
+}
+
+type handler struct {
+	service service.Service
+}
+
+func New(router server.Router, service campaign.Service) Handler {
+	h := handler{
+		service: service,
+	}
+
+	router.AddRoute("POST /campaigns", server.RoleSender, h.create)
+	router.AddRoute("GET /campaigns", server.RoleReadOnly, h.list)
+	router.AddRoute("GET /campaigns/{id}", server.RoleReadOnly, h.get)
+	router.AddRoute("POST /campaigns/{id}/start", server.RoleSender, h.start)
+	router.AddRoute("POST /campaigns/{id}/pause", server.RoleSender, h.pause)
+	router.AddRoute("POST /campaigns/{id}/cancel", server.RoleSender, h.cancel)
+
+	return &h
+}
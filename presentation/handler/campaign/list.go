@@ -0,0 +1,41 @@
+package campaign
+
+import (
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/infrastructure/server"
+)
+
+type listResponse struct {
+	Items []campaignResponse `json:"items"`
+}
+
+// @Summary List campaigns
+// @Description Get all campaigns, newest first
+// @Tags campaigns
+// @Produce json
+// @Success 200 {object} listResponse
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /campaigns [get]
+func (h *handler) list(ctx server.RequestContext) (any, error) {
+	campaigns, err := h.service.List(ctx.Context())
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.List(): %w", err)
+	}
+
+	return campaignsToListResponse(campaigns), nil
+}
+
+func campaignsToListResponse(campaigns []campaign.Campaign) *listResponse {
+	response := listResponse{
+		Items: make([]campaignResponse, 0),
+	}
+
+	for _, campaign := range campaigns {
+		response.Items = append(response.Items, *campaignToCampaignResponse(campaign))
+	}
+
+	return &response
+}
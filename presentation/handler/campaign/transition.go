@@ -0,0 +1,84 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"messager/domain/campaign"
+	"messager/infrastructure/server"
+)
+
+type transitionRequest struct {
+	id string
+}
+
+// @Summary Start a campaign
+// @Description Start dispatching the messages of a draft campaign, or resume a paused one
+// @Tags campaigns
+// @Produce json
+// @Param id path string true "Campaign ID"
+// @Success 200 {object} campaignResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid campaign ID"
+// @Failure 404 {object} server.ErrorResponse "Campaign not found"
+// @Failure 409 {object} server.ErrorResponse "Campaign status does not allow starting"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /campaigns/{id}/start [post]
+func (h *handler) start(ctx server.RequestContext) (any, error) {
+	return h.transition(ctx, h.service.Start)
+}
+
+// @Summary Pause a campaign
+// @Description Stop dispatching the pending messages of a running campaign until it is started again
+// @Tags campaigns
+// @Produce json
+// @Param id path string true "Campaign ID"
+// @Success 200 {object} campaignResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid campaign ID"
+// @Failure 404 {object} server.ErrorResponse "Campaign not found"
+// @Failure 409 {object} server.ErrorResponse "Campaign status does not allow pausing"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /campaigns/{id}/pause [post]
+func (h *handler) pause(ctx server.RequestContext) (any, error) {
+	return h.transition(ctx, h.service.Pause)
+}
+
+// @Summary Cancel a campaign
+// @Description Cancel a campaign and its messages that are not being sent yet
+// @Tags campaigns
+// @Produce json
+// @Param id path string true "Campaign ID"
+// @Success 200 {object} campaignResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid campaign ID"
+// @Failure 404 {object} server.ErrorResponse "Campaign not found"
+// @Failure 409 {object} server.ErrorResponse "Campaign status does not allow cancelling"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /campaigns/{id}/cancel [post]
+func (h *handler) cancel(ctx server.RequestContext) (any, error) {
+	return h.transition(ctx, h.service.Cancel)
+}
+
+func (h *handler) transition(ctx server.RequestContext, transition func(ctx context.Context, id string) (*campaign.Campaign, error)) (any, error) {
+	request := transitionRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	updatedCampaign, err := transition(ctx.Context(), request.id)
+	if errors.Is(err, campaign.ErrCampaignDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, campaign.ErrCampaignNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Campaign not found.", err)
+	}
+	if errors.Is(err, campaign.ErrCampaignStatusTransitionNotAllowed) {
+		return nil, ctx.NewError(server.StatusConflict, "Conflict.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Transition(): %w", err)
+	}
+
+	return campaignToCampaignResponse(*updatedCampaign), nil
+}
@@ -0,0 +1,32 @@
+package consent
+
+import (
+	"time"
+
+	"messager/domain/consent"
+)
+
+type consentResponse struct {
+	ID        string `json:"id" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt string `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Phone     string `json:"phone" example:"+905551234567"`
+	Status    string `json:"status" example:"OPTED_OUT"`
+	Source    string `json:"source" example:"sms-reply:STOP"`
+	Category  string `json:"category" example:"MARKETING"`
+}
+
+func consentToConsentResponse(consent consent.Consent) *consentResponse {
+	response := consentResponse{
+		ID:       consent.ID,
+		Phone:    consent.Phone,
+		Status:   string(consent.Status),
+		Source:   consent.Source,
+		Category: string(consent.Category),
+	}
+
+	if !consent.CreatedAt.IsZero() {
+		response.CreatedAt = consent.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return &response
+}
@@ -0,0 +1,53 @@
+package consent
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/consent"
+	"messager/domain/message"
+	"messager/infrastructure/server"
+)
+
+type createRequest struct {
+	Phone    string `json:"phone" example:"+905551234567"`
+	Status   string `json:"status" example:"OPTED_OUT"`
+	Source   string `json:"source" example:"sms-reply:STOP"`
+	Category string `json:"category,omitempty" example:"MARKETING"`
+}
+
+// @Summary Record a consent
+// @Description Record an opt-in or opt-out of a phone for a category (MARKETING by default). The latest record of a
+// @Description phone and category wins. Marketing messages to opted-out phones are refused at create and send time,
+// @Description transactional messages are always allowed.
+// @Tags consents
+// @Accept json
+// @Produce json
+// @Param consent body createRequest true "Consent object to be recorded"
+// @Success 201 {object} consentResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /consents [post]
+func (h *handler) create(ctx server.RequestContext) (any, error) {
+	var request createRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	newConsent, err := h.service.Create(ctx.Context(), consent.Consent{
+		Phone:    request.Phone,
+		Status:   consent.Status(request.Status),
+		Source:   request.Source,
+		Category: message.Category(request.Category),
+	})
+	if errors.Is(err, consent.ErrConsentDoesNotValidForCreate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Create(): %w", err)
+	}
+
+	return consentToConsentResponse(*newConsent), nil
+}
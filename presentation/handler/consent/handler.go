@@ -0,0 +1,26 @@
+package consent
+
+import (
+	"messager/domain/consent"
+	service "messager/domain/consent"
+	"messager/infrastructure/server"
+)
+
+type Handler interface {
+	create(ctx server.RequestContext) (any, error)
+}
+
+type handler struct {
+	service service.Service
+}
+
+func New(router server.Router, service consent.Service) Handler {
+	h := handler{
+		service: service,
+	}
+
+	router.AddRoute("POST /consents", server.RoleSender, h.create)
+	router.AddRoute("GET /consents", server.RoleReadOnly, h.listByPhone)
+
+	return &h
+}
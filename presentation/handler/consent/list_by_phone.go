@@ -0,0 +1,55 @@
+package consent
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/consent"
+	"messager/infrastructure/server"
+)
+
+type listByPhoneRequest struct {
+	phone string
+}
+
+type listByPhoneResponse struct {
+	Items []consentResponse `json:"items"`
+}
+
+// @Summary List consents of a phone
+// @Description Get the consent history of a phone, newest first
+// @Tags consents
+// @Produce json
+// @Param phone query string true "Phone number"
+// @Success 200 {object} listByPhoneResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid phone"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /consents [get]
+func (h *handler) listByPhone(ctx server.RequestContext) (any, error) {
+	request := listByPhoneRequest{
+		phone: ctx.GetQuery("phone"),
+	}
+
+	consents, err := h.service.ListByPhone(ctx.Context(), request.phone)
+	if errors.Is(err, consent.ErrConsentDoesNotValidForListByPhone) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.ListByPhone(): %w", err)
+	}
+
+	return consentsToListByPhoneResponse(consents), nil
+}
+
+func consentsToListByPhoneResponse(consents []consent.Consent) *listByPhoneResponse {
+	response := listByPhoneResponse{
+		Items: make([]consentResponse, 0),
+	}
+
+	for _, consent := range consents {
+		response.Items = append(response.Items, *consentToConsentResponse(consent))
+	}
+
+	return &response
+}
@@ -3,20 +3,50 @@ package message
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	"messager/domain/fault"
 	"messager/domain/message"
+	"messager/domain/tenant"
 	"messager/infrastructure/server"
 )
 
+const (
+	localTimeLayout      = "2006-01-02T15:04:05"
+	idempotencyKeyHeader = "Idempotency-Key"
+)
+
 // @Summary Create a new message
-// @Description Create a new message with content and phone number
+// @Description Create a new message with content and phone number. The message is sent immediately unless sendAt is provided.
+// @Description channel is SMS (default) or EMAIL. Email messages take email and subject instead of phone, and an optional
+// @Description html alternative to content. Email is only accepted when an SMTP server is configured.
+// @Description metadata (up to 20 keys) and tags (up to 20) are stored with the message and can be filtered on in
+// @Description GET /messages.
+// @Description from picks the sender of an SMS from the sender pool; when omitted, one is selected with SENDER_STRATEGY.
+// @Description sendAt is an RFC 3339 timestamp, or a local time (2006-01-02T15:04:05) when timeZone is provided.
+// @Description timeZone is either an IANA time zone or "recipient" for the local time of the phone's region.
+// @Description validUntil is an RFC 3339 timestamp after which the message expires instead of being sent.
+// @Description priority is one of TRANSACTIONAL, NORMAL (default) or BULK.
+// @Description category is TRANSACTIONAL or MARKETING (default). Marketing messages to opted-out phones are refused.
+// @Description templateId and variables may be provided instead of content to render a template. The template variant is
+// @Description selected by locale, or by the phone's region when locale is omitted.
+// @Description An Idempotency-Key header makes retries return the message created by the first request. Reusing a key
+// @Description with a different request is rejected.
+// @Description Messages count against the daily quota of the caller's tenant.
+// @Description Errors are RFC 7807 problems with a stable code, and the invalid fields in errors. Content policy
+// @Description violations are reported as errors of the content field.
 // @Tags messages
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "Key identifying the request across retries"
 // @Param message body createRequest true "Message object to be created"
 // @Success 201 {object} createResponse
 // @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 409 {object} server.ErrorResponse "Idempotency key reused or in progress"
+// @Failure 422 {object} server.ErrorResponse "Recipient opted out"
+// @Failure 429 {object} server.ErrorResponse "Tenant daily quota exceeded"
 // @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
 // @Router /messages [post]
 func (h *handler) create(ctx server.RequestContext) (any, error) {
 	var request createRequest
@@ -25,10 +55,26 @@ func (h *handler) create(ctx server.RequestContext) (any, error) {
 		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
 	}
 
-	newMessage, err := h.service.Create(ctx.Context(), request.toMessage())
+	requestMessage, err := request.toMessage()
+	if err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	requestMessage.IdempotencyKey = ctx.GetHeader(idempotencyKeyHeader)
+
+	newMessage, err := h.service.Create(ctx.Context(), requestMessage)
 	if errors.Is(err, message.ErrMessageDoesNotValidForCreate) {
 		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
 	}
+	if errors.Is(err, message.ErrMessageIdempotencyKeyReused) || errors.Is(err, message.ErrMessageIdempotencyKeyInProgress) {
+		return nil, ctx.NewError(server.StatusConflict, "Conflict.", err)
+	}
+	if errors.Is(err, message.ErrMessageRecipientOptedOut) {
+		return nil, ctx.NewError(server.StatusUnprocessableEntity, "Recipient opted out.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantQuotaExceeded) {
+		return nil, ctx.NewError(server.StatusTooManyRequests, "Daily quota exceeded.", err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("handler.service.Create(): %w", err)
 	}
@@ -36,25 +82,94 @@ func (h *handler) create(ctx server.RequestContext) (any, error) {
 	return messageToCreateResponse(*newMessage), nil
 }
 
-func (l *createRequest) toMessage() message.Message {
-	return message.Message{
-		Content: l.Content,
-		Phone:   l.Phone,
-		Status:  message.StatusPending,
+func (l *createRequest) toMessage() (message.Message, error) {
+	newMessage := message.Message{
+		Channel:     message.Channel(l.Channel),
+		Content:     l.Content,
+		HTMLContent: l.HTML,
+		Subject:     l.Subject,
+		Phone:       l.Phone,
+		Email:       l.Email,
+		Sender:      l.From,
+		Status:      message.StatusPending,
+		TimeZone:    l.TimeZone,
+		Priority:    message.Priority(l.Priority),
+		Category:    message.Category(l.Category),
+		TemplateID:  l.TemplateID,
+		Locale:      l.Locale,
+		Variables:   l.Variables,
+		Metadata:    l.Metadata,
+		Tags:        l.Tags,
+	}
+
+	if l.SendAt != "" {
+		layout := time.RFC3339
+		if l.TimeZone != "" {
+			layout = localTimeLayout
+		}
+
+		sendAt, err := time.Parse(layout, l.SendAt)
+		if err != nil {
+			return message.Message{}, fault.NewFieldError("sendAt", fault.CodeInvalid,
+				fmt.Sprintf("message send at must be formatted as %s", layout), map[string]any{"layout": layout})
+		}
+
+		newMessage.SendAt = sendAt
 	}
+
+	if l.ValidUntil != "" {
+		validUntil, err := time.Parse(time.RFC3339, l.ValidUntil)
+		if err != nil {
+			return message.Message{}, fault.NewFieldError("validUntil", fault.CodeInvalid,
+				fmt.Sprintf("message valid until must be formatted as %s", time.RFC3339), map[string]any{"layout": time.RFC3339})
+		}
+
+		newMessage.ValidUntil = validUntil
+	}
+
+	return newMessage, nil
 }
 
 func messageToCreateResponse(message message.Message) *createResponse {
 	return &createResponse{
-		ID: message.ID,
+		ID:       message.ID,
+		Channel:  string(message.Channel),
+		Phone:    message.Phone,
+		Email:    message.Email,
+		From:     message.Sender,
+		Encoding: string(message.Encoding),
+		Segments: message.Segments,
+		Locale:   message.Locale,
 	}
 }
 
 type createRequest struct {
-	Content string `json:"content" example:"Hello, world!"`
-	Phone   string `json:"phone" example:"+905551234567"`
+	Channel    string            `json:"channel,omitempty" example:"SMS"`
+	Content    string            `json:"content" example:"Hello, world!"`
+	Phone      string            `json:"phone,omitempty" example:"+905551234567"`
+	Email      string            `json:"email,omitempty" example:"jane@example.com"`
+	From       string            `json:"from,omitempty" example:"+905550000000"`
+	Subject    string            `json:"subject,omitempty" example:"Your order has shipped"`
+	HTML       string            `json:"html,omitempty" example:"<p>Hello, world!</p>"`
+	SendAt     string            `json:"sendAt,omitempty" example:"2025-01-01T09:00:00"`
+	TimeZone   string            `json:"timeZone,omitempty" example:"recipient"`
+	ValidUntil string            `json:"validUntil,omitempty" example:"2025-01-01T10:00:00Z"`
+	Priority   string            `json:"priority,omitempty" example:"TRANSACTIONAL"`
+	Category   string            `json:"category,omitempty" example:"TRANSACTIONAL"`
+	TemplateID string            `json:"templateId,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	Variables  map[string]string `json:"variables,omitempty"`
+	Locale     string            `json:"locale,omitempty" example:"tr"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Tags       []string          `json:"tags,omitempty" example:"order-update"`
 }
 
 type createResponse struct {
-	ID string `json:"id" example:"a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"`
+	ID       string `json:"id" example:"a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"`
+	Channel  string `json:"channel" example:"SMS"`
+	Phone    string `json:"phone" example:"+905551234567"`
+	Email    string `json:"email,omitempty" example:"jane@example.com"`
+	From     string `json:"from,omitempty" example:"+905550000000"`
+	Encoding string `json:"encoding" example:"GSM-7"`
+	Segments int    `json:"segments" example:"1"`
+	Locale   string `json:"locale,omitempty" example:"tr"`
 }
@@ -0,0 +1,219 @@
+package message
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+type createBatchRequest struct {
+	Items []createRequest `json:"items"`
+}
+
+type createBatchResponse struct {
+	Created int                       `json:"created" example:"1"`
+	Failed  int                       `json:"failed" example:"1"`
+	Items   []createBatchResponseItem `json:"items"`
+}
+
+type createBatchResponseItem struct {
+	Index    int                         `json:"index" example:"0"`
+	ID       string                      `json:"id,omitempty" example:"a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"`
+	Channel  string                      `json:"channel,omitempty" example:"SMS"`
+	Phone    string                      `json:"phone,omitempty" example:"+905551234567"`
+	Email    string                      `json:"email,omitempty" example:"jane@example.com"`
+	From     string                      `json:"from,omitempty" example:"+905550000000"`
+	Encoding string                      `json:"encoding,omitempty" example:"GSM-7"`
+	Segments int                         `json:"segments,omitempty" example:"1"`
+	Locale   string                      `json:"locale,omitempty" example:"tr"`
+	Error    string                      `json:"error,omitempty" example:"message does not valid for create\nmessage content must be provided"`
+	Code     string                      `json:"code,omitempty" example:"MESSAGE_INVALID_FOR_CREATE"`
+	Errors   []server.FieldErrorResponse `json:"errors,omitempty"`
+}
+
+// @Summary Create messages in batch
+// @Description Create up to MESSAGE_MAX_BATCH_SIZE messages in one request. Every item accepts the fields of POST /messages.
+// @Description Valid items are created together, invalid items are reported with their index and error without failing
+// @Description the batch. Failed items carry the code and invalid fields of their error, as problems do. The valid
+// @Description items are refused together when they exceed the daily quota of the caller's tenant.
+// @Tags messages
+// @Accept json
+// @Produce json
+// @Param messages body createBatchRequest true "Messages to be created"
+// @Success 201 {object} createBatchResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 429 {object} server.ErrorResponse "Tenant daily quota exceeded"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /messages/batch [post]
+func (h *handler) createBatch(ctx server.RequestContext) (any, error) {
+	request, err := decodeCreateBatchRequest(ctx.GetBody(), h.maxBatchSize)
+	if err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	response := createBatchResponse{
+		Items: make([]createBatchResponseItem, len(request.Items)),
+	}
+
+	requestMessages := make([]message.Message, 0, len(request.Items))
+	indexes := make([]int, 0, len(request.Items))
+
+	for i, item := range request.Items {
+		requestMessage, err := item.toMessage()
+		if err != nil {
+			err = errors.Join(message.ErrMessageDoesNotValidForCreate, err)
+
+			response.Items[i] = createBatchResponseItem{
+				Index:  i,
+				Error:  err.Error(),
+				Code:   server.ErrorCode(err),
+				Errors: server.FieldErrors(err),
+			}
+
+			continue
+		}
+
+		requestMessages = append(requestMessages, requestMessage)
+		indexes = append(indexes, i)
+	}
+
+	// Items that failed to parse are already reported.
+	if len(requestMessages) > 0 {
+		results, err := h.service.CreateBatch(ctx.Context(), requestMessages)
+		if errors.Is(err, message.ErrMessageDoesNotValidForCreateBatch) {
+			return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+		}
+		if errors.Is(err, tenant.ErrTenantQuotaExceeded) {
+			return nil, ctx.NewError(server.StatusTooManyRequests, "Daily quota exceeded.", err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("handler.service.CreateBatch(): %w", err)
+		}
+
+		for i, result := range results {
+			response.Items[indexes[i]] = resultToCreateBatchResponseItem(indexes[i], result)
+		}
+	}
+
+	for _, item := range response.Items {
+		if item.Error != "" {
+			response.Failed++
+		} else {
+			response.Created++
+		}
+	}
+
+	return &response, nil
+}
+
+func resultToCreateBatchResponseItem(index int, result message.CreateResult) createBatchResponseItem {
+	if result.Err != nil {
+		return createBatchResponseItem{
+			Index:  index,
+			Error:  result.Err.Error(),
+			Code:   server.ErrorCode(result.Err),
+			Errors: server.FieldErrors(result.Err),
+		}
+	}
+
+	return createBatchResponseItem{
+		Index:    index,
+		ID:       result.Message.ID,
+		Channel:  string(result.Message.Channel),
+		Phone:    result.Message.Phone,
+		Email:    result.Message.Email,
+		From:     result.Message.Sender,
+		Encoding: string(result.Message.Encoding),
+		Segments: result.Message.Segments,
+		Locale:   result.Message.Locale,
+	}
+}
+
+// decodeCreateBatchRequest decodes the body item by item and stops at the item
+// past maxBatchSize, so that an oversized batch is refused without reading the
+// rest of it.
+func decodeCreateBatchRequest(body io.Reader, maxBatchSize int) (createBatchRequest, error) {
+	var request createBatchRequest
+
+	decoder := json.NewDecoder(body)
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		if errors.Is(err, io.EOF) {
+			return request, validateBatchSize(0, maxBatchSize)
+		}
+
+		return request, err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return request, fmt.Errorf("json.Decoder.Token(): %w", err)
+		}
+
+		if token != "items" {
+			var skipped json.RawMessage
+
+			if err := decoder.Decode(&skipped); err != nil {
+				return request, fmt.Errorf("json.Decoder.Decode(): %w", err)
+			}
+
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return request, err
+		}
+
+		for decoder.More() {
+			if len(request.Items) == maxBatchSize {
+				return request, validateBatchSize(maxBatchSize+1, maxBatchSize)
+			}
+
+			var item createRequest
+
+			if err := decoder.Decode(&item); err != nil {
+				return request, fmt.Errorf("json.Decoder.Decode(): %w", err)
+			}
+
+			request.Items = append(request.Items, item)
+		}
+
+		if err := expectDelim(decoder, ']'); err != nil {
+			return request, err
+		}
+	}
+
+	if err := expectDelim(decoder, '}'); err != nil {
+		return request, err
+	}
+
+	return request, validateBatchSize(len(request.Items), maxBatchSize)
+}
+
+func validateBatchSize(size, maxBatchSize int) error {
+	if err := message.ValidateBatchSize(size, maxBatchSize); err != nil {
+		return errors.Join(message.ErrMessageDoesNotValidForCreateBatch, err)
+	}
+
+	return nil
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("json.Decoder.Token(): %w", err)
+	}
+
+	if token != delim {
+		return fmt.Errorf("json.Decoder.Token(): expected %s, found %v", delim, token)
+	}
+
+	return nil
+}
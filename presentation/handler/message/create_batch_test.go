@@ -0,0 +1,60 @@
+package message
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"messager/domain/fault"
+	"messager/domain/message"
+)
+
+// unreadable fails the test when the decoder reads it.
+type unreadable struct {
+	t *testing.T
+}
+
+func (r unreadable) Read([]byte) (int, error) {
+	r.t.Error("the body past the oversized item must not be read")
+
+	return 0, errors.New("unreadable")
+}
+
+func TestDecodeCreateBatchRequest(t *testing.T) {
+	item := `{"content":"Hello","phone":"+905551234567"}`
+
+	t.Run("items", func(t *testing.T) {
+		request, err := decodeCreateBatchRequest(strings.NewReader(`{"other":{"a":[1]},"items":[`+item+`,`+item+`]}`), 2)
+		assert.NoError(t, err)
+		assert.Len(t, request.Items, 2)
+		assert.Equal(t, "Hello", request.Items[1].Content)
+	})
+
+	t.Run("oversized batch is not read past the limit", func(t *testing.T) {
+		body := io.MultiReader(strings.NewReader(`{"items":[`+item+`,`+item+`,`+item+`,`), unreadable{t: t})
+
+		_, err := decodeCreateBatchRequest(body, 2)
+		assert.ErrorIs(t, err, message.ErrMessageDoesNotValidForCreateBatch)
+
+		var fieldErr *fault.FieldError
+		assert.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "items", fieldErr.Field())
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		_, err := decodeCreateBatchRequest(strings.NewReader(""), 2)
+		assert.ErrorIs(t, err, message.ErrMessageDoesNotValidForCreateBatch)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := decodeCreateBatchRequest(strings.NewReader(`{"items":{}}`), 2)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, message.ErrMessageDoesNotValidForCreateBatch)
+
+		_, err = decodeCreateBatchRequest(strings.NewReader(`{"items":[`+item), 2)
+		assert.Error(t, err)
+	})
+}
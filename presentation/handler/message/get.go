@@ -0,0 +1,89 @@
+package message
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+	"messager/infrastructure/server"
+)
+
+type getRequest struct {
+	id string
+}
+
+type getResponse struct {
+	listByStatusResponseItem
+	Attempts []getResponseAttempt `json:"attempts"`
+}
+
+type getResponseAttempt struct {
+	ID                string `json:"id,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt         string `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Provider          string `json:"provider,omitempty" example:"sms.example.com"`
+	ProviderMessageID string `json:"providerMessageId,omitempty" example:"67f2f8a8-ea58-4ed0-a6f9-ff217df4d849"`
+	Status            string `json:"status,omitempty" example:"SUCCEEDED"`
+	ResponseStatus    int    `json:"responseStatus,omitempty" example:"202"`
+	LatencyMS         int64  `json:"latencyMs" example:"120"`
+	ErrorClass        string `json:"errorClass,omitempty" example:"TEMPORARY"`
+}
+
+// @Summary Get a message
+// @Description Get a message with its delivery attempts, in the order they were made. An attempt records the provider,
+// @Description the ID the provider gave the message, the status code it replied with and the latency of the request.
+// @Description Failed attempts are classified as TEMPORARY when the message is retried, PERMANENT otherwise.
+// @Tags messages
+// @Produce json
+// @Param id path string true "Message ID"
+// @Success 200 {object} getResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid message ID"
+// @Failure 404 {object} server.ErrorResponse "Message not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /messages/{id} [get]
+func (h *handler) get(ctx server.RequestContext) (any, error) {
+	request := getRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	foundMessage, err := h.service.Get(ctx.Context(), request.id)
+	if errors.Is(err, message.ErrMessageDoesNotValidForGet) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, message.ErrMessageNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Message not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Get(): %w", err)
+	}
+
+	return messageToGetResponse(*foundMessage), nil
+}
+
+func messageToGetResponse(message message.Message) *getResponse {
+	response := getResponse{
+		listByStatusResponseItem: *messageToListByStatusResponseItem(message),
+		Attempts:                 make([]getResponseAttempt, 0, len(message.Attempts)),
+	}
+
+	for _, attempt := range message.Attempts {
+		item := getResponseAttempt{
+			ID:                attempt.ID,
+			Provider:          attempt.Provider,
+			ProviderMessageID: attempt.ProviderMessageID,
+			Status:            string(attempt.Status),
+			ResponseStatus:    attempt.ResponseStatus,
+			LatencyMS:         attempt.Latency.Milliseconds(),
+			ErrorClass:        string(attempt.ErrorClass),
+		}
+
+		if !attempt.CreatedAt.IsZero() {
+			item.CreatedAt = attempt.CreatedAt.Format(time.RFC3339)
+		}
+
+		response.Attempts = append(response.Attempts, item)
+	}
+
+	return &response
+}
@@ -3,6 +3,7 @@ package message
 import (
 	"messager/domain/message"
 	service "messager/domain/message"
+	"messager/domain/tenant"
 	"messager/infrastructure/server"
 	job "messager/presentation/job/message"
 )
@@ -12,20 +13,29 @@ type Handler interface {
 }
 
 type handler struct {
-	service service.Service
-	job     job.Job
+	service      service.Service
+	tenants      tenant.Service
+	job          job.Job
+	maxBatchSize int
 }
 
-func New(router server.Router, service message.Service, job job.Job) Handler {
+func New(router server.Router, service message.Service, tenants tenant.Service, job job.Job, maxBatchSize int) Handler {
 	h := handler{
-		service: service,
-		job:     job,
+		service:      service,
+		tenants:      tenants,
+		job:          job,
+		maxBatchSize: maxBatchSize,
 	}
 
-	router.AddRoute("POST /messages", h.create)
-	router.AddRoute("GET /messages", h.listByStatus)
-	router.AddRoute("POST /messages/jobs", h.startJob)
-	router.AddRoute("DELETE /messages/jobs", h.stopJob)
+	router.AddRoute("POST /messages", server.RoleSender, h.create)
+	router.AddRoute("POST /messages/batch", server.RoleSender, h.createBatch)
+	router.AddRoute("GET /messages", server.RoleReadOnly, h.listByStatus)
+	router.AddRoute("GET /messages/{id}", server.RoleReadOnly, h.get)
+	router.AddRoute("GET /messages/{id}/events", server.RoleReadOnly, h.listEvents)
+	router.AddRoute("POST /messages/jobs", server.RoleAdmin, h.startJob)
+	router.AddRoute("DELETE /messages/jobs", server.RoleAdmin, h.stopJob)
+	router.AddRoute("POST /jobs", server.RoleAdmin, h.startProcessing)
+	router.AddRoute("DELETE /jobs", server.RoleAdmin, h.stopProcessing)
 
 	return &h
 }
@@ -0,0 +1,167 @@
+package message_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apikeyservice "messager/application/service/apikey"
+	messageservice "messager/application/service/message"
+	tenantservice "messager/application/service/tenant"
+	"messager/domain/apikey"
+	"messager/domain/message"
+	"messager/domain/tenant"
+	"messager/infrastructure/client"
+	"messager/infrastructure/database/redis"
+	"messager/infrastructure/persistence/memory"
+	"messager/infrastructure/server"
+	messagehandler "messager/presentation/handler/message"
+	messagejob "messager/presentation/job/message"
+)
+
+type fakeClient struct{}
+
+func (c *fakeClient) Provider() string {
+	return "sms.example.com"
+}
+
+func (c *fakeClient) SendMessage(ctx context.Context, message message.Message) (client.Response, error) {
+	return client.Response{ID: "provider-message-id", Status: http.StatusAccepted}, nil
+}
+
+// TestHandler_Embedded creates, sends and reads a message over HTTP, with the
+// services running on the embedded store like in MESSAGER_MODE=embedded.
+func TestHandler_Embedded(t *testing.T) {
+	store := memory.NewStore()
+	messageRepository := memory.NewMessageRepository(store, redis.NewMemory())
+	tenantRepository := memory.NewTenantRepository(store)
+
+	apiKeyService := apikeyservice.New(memory.NewAPIKeyRepository(store), tenantRepository)
+	tenantService := tenantservice.New(tenantRepository)
+	messageService := messageservice.New(
+		messageRepository, memory.NewTemplateRepository(store), memory.NewConsentRepository(store),
+		memory.NewPolicyRepository(store), memory.NewSenderRepository(store),
+		map[message.Channel]client.Client{message.ChannelSMS: &fakeClient{}},
+		messageservice.Config{
+			BatchSizes:      map[message.Priority]int{message.PriorityNormal: 10},
+			CreateOptions:   message.CreateOptions{MaxSegments: 4, DefaultRegion: "TR"},
+			MaxBatchSize:    10,
+			OutboxBatchSize: 10,
+		},
+	)
+
+	key, err := apiKeyService.Create(tenant.NewContext(context.Background(), tenant.Default()), apikey.APIKey{Name: "test", Role: apikey.RoleSender})
+	require.NoError(t, err)
+
+	listening := make(chan string, 1)
+
+	srv := server.New(server.Config{
+		Host: "127.0.0.1",
+		OnListen: func(address string) {
+			listening <- address
+		},
+		OnAuthenticate: func(ctx server.RequestContext, credentials string) (context.Context, server.Identity, error) {
+			found, err := apiKeyService.Authenticate(ctx.Context(), credentials)
+			if err != nil {
+				return nil, server.Identity{}, ctx.NewError(server.StatusUnauthorized, "Unauthorized.", err)
+			}
+
+			current, err := tenantService.Resolve(ctx.Context(), found.TenantID)
+			if err != nil {
+				return nil, server.Identity{}, fmt.Errorf("tenantService.Resolve(): %w", err)
+			}
+
+			return tenant.NewContext(ctx.Context(), *current), server.Identity{
+				KeyID:    found.ID,
+				TenantID: found.TenantID,
+				Role:     server.RoleSender,
+			}, nil
+		},
+	})
+
+	_ = messagehandler.New(srv.NewRouter(), messageService, tenantService, messagejob.New(messageService, 0, nil), 10)
+
+	go func() {
+		_ = srv.Start()
+	}()
+
+	t.Cleanup(func() {
+		_ = srv.Stop()
+	})
+
+	baseURL := "http://" + <-listening
+
+	do := func(method, path string, body any) (int, map[string]any) {
+		var payload bytes.Buffer
+
+		if body != nil {
+			require.NoError(t, json.NewEncoder(&payload).Encode(body))
+		}
+
+		request, err := http.NewRequest(method, baseURL+path, &payload)
+		require.NoError(t, err)
+
+		request.Header.Set("Authorization", "Bearer "+key.Secret)
+		request.Header.Set("Content-Type", "application/json")
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+
+		defer response.Body.Close()
+
+		var decoded map[string]any
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&decoded))
+
+		return response.StatusCode, decoded
+	}
+
+	status, created := do(http.MethodPost, "/messages", map[string]any{
+		"content":  "Your code is 1234",
+		"phone":    "+905551234567",
+		"category": "TRANSACTIONAL",
+	})
+	require.Equal(t, http.StatusOK, status, created)
+
+	id := created["id"].(string)
+
+	status, found := do(http.MethodGet, "/messages/"+id, nil)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "PENDING", found["status"])
+
+	ctx := tenant.NewContext(context.Background(), tenant.Tenant{ID: tenant.DefaultID})
+
+	require.NoError(t, messageService.Process(ctx))
+	require.NoError(t, messageService.Relay(ctx))
+
+	status, found = do(http.MethodGet, "/messages/"+id, nil)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "SENT", found["status"])
+	assert.Len(t, found["attempts"], 1)
+
+	status, listed := do(http.MethodGet, "/messages?status=SENT&q=code", nil)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Len(t, listed["items"], 1)
+
+	items := make([]map[string]any, 11)
+	for i := range items {
+		items[i] = map[string]any{"content": "Hello", "phone": "+905551234567", "sendAt": "not a time"}
+	}
+
+	status, _ = do(http.MethodPost, "/messages/batch", map[string]any{"items": items})
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	request, err := http.NewRequest(http.MethodGet, baseURL+"/messages", nil)
+	require.NoError(t, err)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+}
@@ -3,44 +3,107 @@ package message
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"messager/domain/fault"
 	"messager/domain/message"
 	"messager/infrastructure/server"
 )
 
 type listByStatusRequest struct {
-	status string
+	status        string
+	phone         string
+	createdFrom   string
+	createdTo     string
+	updatedFrom   string
+	updatedTo     string
+	query         string
+	tag           string
+	metadataKey   string
+	metadataValue string
+	limit         string
+	cursor        string
 }
 
 type listByStatusResponse struct {
-	Items []listByStatusResponseItem `json:"items"`
+	Items      []listByStatusResponseItem `json:"items"`
+	NextCursor string                     `json:"nextCursor,omitempty" example:"eyJjIjoiMjAyMy0xMC0yN1QxMDowMDowMFoiLCJpIjoiYTFiMmMzZDQifQ"`
 }
 
 type listByStatusResponseItem struct {
-	ID        string `json:"id,omitempty" example:"a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"`
-	CreatedAt string `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
-	UpdatedAt string `json:"updatedAt,omitempty" example:"2023-10-27T10:00:00Z"`
-	Content   string `json:"content,omitempty" example:"Hello from Swagger!"`
-	Phone     string `json:"phone,omitempty" example:"+905551234567"`
-	Status    string `json:"status,omitempty" example:"PENDING"`
+	ID          string            `json:"id,omitempty" example:"a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"`
+	CreatedAt   string            `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	UpdatedAt   string            `json:"updatedAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Content     string            `json:"content,omitempty" example:"Hello from Swagger!"`
+	Channel     string            `json:"channel,omitempty" example:"SMS"`
+	Phone       string            `json:"phone,omitempty" example:"+905551234567"`
+	Email       string            `json:"email,omitempty" example:"jane@example.com"`
+	From        string            `json:"from,omitempty" example:"+905550000000"`
+	Subject     string            `json:"subject,omitempty" example:"Your order has shipped"`
+	Status      string            `json:"status,omitempty" example:"PENDING"`
+	SendAt      string            `json:"sendAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	ValidUntil  string            `json:"validUntil,omitempty" example:"2023-10-27T11:00:00Z"`
+	Priority    string            `json:"priority,omitempty" example:"NORMAL"`
+	Category    string            `json:"category,omitempty" example:"MARKETING"`
+	Encoding    string            `json:"encoding,omitempty" example:"GSM-7"`
+	Segments    int               `json:"segments,omitempty" example:"1"`
+	CountryCode int               `json:"countryCode,omitempty" example:"90"`
+	Region      string            `json:"region,omitempty" example:"TR"`
+	TemplateID  string            `json:"templateId,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	Locale      string            `json:"locale,omitempty" example:"tr"`
+	CampaignID  string            `json:"campaignId,omitempty" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	Tags        []string          `json:"tags,omitempty" example:"order-update"`
 }
 
-// @Summary List messages by status
-// @Description Get a list of messages filtered by their status
+// @Summary List messages
+// @Description Get a page of messages, newest first, optionally filtered by status, phone, creation and update time,
+// @Description content, tag or metadata. Messages with the metadata key are listed regardless of its value unless
+// @Description metadataValue is provided. Pass nextCursor of a page as cursor to get the next one, the last page has
+// @Description no nextCursor.
 // @Tags messages
 // @Produce json
-// @Param status query string true "Message status (e.g., PENDING, SENT)"
+// @Param status query string false "Comma separated message statuses (e.g., PENDING or SENT,DELIVERED)"
+// @Param phone query string false "Phone the messages are sent to, in E.164 or local to the default region of the tenant"
+// @Param createdFrom query string false "RFC 3339 time the messages are created at or after"
+// @Param createdTo query string false "RFC 3339 time the messages are created before"
+// @Param updatedFrom query string false "RFC 3339 time the messages are updated at or after"
+// @Param updatedTo query string false "RFC 3339 time the messages are updated before"
+// @Param q query string false "Words the content of the messages must contain"
+// @Param tag query string false "Tag the messages must have"
+// @Param metadataKey query string false "Metadata key the messages must have"
+// @Param metadataValue query string false "Value of metadataKey the messages must have"
+// @Param limit query int false "Maximum number of messages in the page, 50 by default and at most 500"
+// @Param cursor query string false "nextCursor of the previous page"
 // @Success 200 {object} listByStatusResponse
-// @Failure 400 {object} server.ErrorResponse "Invalid status parameter"
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
 // @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
 // @Router /messages [get]
 func (h *handler) listByStatus(ctx server.RequestContext) (any, error) {
 	request := listByStatusRequest{
-		status: ctx.GetQuery("status"),
+		status:        ctx.GetQuery("status"),
+		phone:         ctx.GetQuery("phone"),
+		createdFrom:   ctx.GetQuery("createdFrom"),
+		createdTo:     ctx.GetQuery("createdTo"),
+		updatedFrom:   ctx.GetQuery("updatedFrom"),
+		updatedTo:     ctx.GetQuery("updatedTo"),
+		query:         ctx.GetQuery("q"),
+		tag:           ctx.GetQuery("tag"),
+		metadataKey:   ctx.GetQuery("metadataKey"),
+		metadataValue: ctx.GetQuery("metadataValue"),
+		limit:         ctx.GetQuery("limit"),
+		cursor:        ctx.GetQuery("cursor"),
 	}
 
-	messages, err := h.service.ListByStatus(ctx.Context(), message.Status(request.status))
+	filter, err := request.toListFilter()
+	if err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	page, err := h.service.ListByStatus(ctx.Context(), filter)
 	if errors.Is(err, message.ErrMessageDoesNotValidForListByStatus) {
 		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
 	}
@@ -48,15 +111,69 @@ func (h *handler) listByStatus(ctx server.RequestContext) (any, error) {
 		return nil, fmt.Errorf("handler.service.ListByStatus(): %w", err)
 	}
 
-	return messagesToListByStatusResponse(messages), nil
+	return pageToListByStatusResponse(*page), nil
 }
 
-func messagesToListByStatusResponse(messages []message.Message) *listByStatusResponse {
+func (r *listByStatusRequest) toListFilter() (message.ListFilter, error) {
+	filter := message.ListFilter{
+		Phone:         r.phone,
+		Query:         r.query,
+		Tag:           r.tag,
+		MetadataKey:   r.metadataKey,
+		MetadataValue: r.metadataValue,
+		Cursor:        r.cursor,
+	}
+
+	if r.status != "" {
+		for _, status := range strings.Split(r.status, ",") {
+			filter.Statuses = append(filter.Statuses, message.Status(strings.TrimSpace(status)))
+		}
+	}
+
+	times := []struct {
+		field  string
+		value  string
+		target *time.Time
+	}{
+		{field: "createdFrom", value: r.createdFrom, target: &filter.CreatedFrom},
+		{field: "createdTo", value: r.createdTo, target: &filter.CreatedTo},
+		{field: "updatedFrom", value: r.updatedFrom, target: &filter.UpdatedFrom},
+		{field: "updatedTo", value: r.updatedTo, target: &filter.UpdatedTo},
+	}
+
+	for _, t := range times {
+		if t.value == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, t.value)
+		if err != nil {
+			return message.ListFilter{}, fault.NewFieldError(t.field, fault.CodeInvalid,
+				fmt.Sprintf("message %s must be formatted as %s", t.field, time.RFC3339), map[string]any{"layout": time.RFC3339})
+		}
+
+		*t.target = parsed
+	}
+
+	if r.limit != "" {
+		limit, err := strconv.Atoi(r.limit)
+		if err != nil {
+			return message.ListFilter{}, fault.NewFieldError("limit", fault.CodeInvalid, "message list limit must be a number", nil)
+		}
+
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+func pageToListByStatusResponse(page message.Page) *listByStatusResponse {
 	response := listByStatusResponse{
-		Items: make([]listByStatusResponseItem, 0),
+		Items:      make([]listByStatusResponseItem, 0, len(page.Items)),
+		NextCursor: page.NextCursor,
 	}
 
-	for _, message := range messages {
+	for _, message := range page.Items {
 		response.Items = append(response.Items, *messageToListByStatusResponseItem(message))
 	}
 
@@ -65,10 +182,25 @@ func messagesToListByStatusResponse(messages []message.Message) *listByStatusRes
 
 func messageToListByStatusResponseItem(message message.Message) *listByStatusResponseItem {
 	item := listByStatusResponseItem{
-		ID:      message.ID,
-		Content: message.Content,
-		Phone:   message.Phone,
-		Status:  string(message.Status),
+		ID:          message.ID,
+		Content:     message.Content,
+		Channel:     string(message.Channel),
+		Phone:       message.Phone,
+		Email:       message.Email,
+		From:        message.Sender,
+		Subject:     message.Subject,
+		Status:      string(message.Status),
+		Priority:    string(message.Priority),
+		Category:    string(message.Category),
+		Encoding:    string(message.Encoding),
+		Segments:    message.Segments,
+		CountryCode: message.CountryCode,
+		Region:      message.Region,
+		TemplateID:  message.TemplateID,
+		Locale:      message.Locale,
+		CampaignID:  message.CampaignID,
+		Metadata:    message.Metadata,
+		Tags:        message.Tags,
 	}
 
 	if !message.CreatedAt.IsZero() {
@@ -79,5 +211,13 @@ func messageToListByStatusResponseItem(message message.Message) *listByStatusRes
 		item.UpdatedAt = message.UpdatedAt.Format(time.RFC3339)
 	}
 
+	if !message.SendAt.IsZero() {
+		item.SendAt = message.SendAt.Format(time.RFC3339)
+	}
+
+	if !message.ValidUntil.IsZero() {
+		item.ValidUntil = message.ValidUntil.Format(time.RFC3339)
+	}
+
 	return &item
 }
@@ -0,0 +1,81 @@
+package message
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"messager/domain/message"
+	"messager/infrastructure/server"
+)
+
+type listEventsRequest struct {
+	id string
+}
+
+type listEventsResponse struct {
+	Items []listEventsResponseItem `json:"items"`
+}
+
+type listEventsResponseItem struct {
+	ID        string `json:"id,omitempty" example:"a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6"`
+	CreatedAt string `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	From      string `json:"from,omitempty" example:"PENDING"`
+	To        string `json:"to,omitempty" example:"SENDING"`
+}
+
+// @Summary List message events
+// @Description Get the status transition history of a message
+// @Tags messages
+// @Produce json
+// @Param id path string true "Message ID"
+// @Success 200 {object} listEventsResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid message ID"
+// @Failure 404 {object} server.ErrorResponse "Message not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /messages/{id}/events [get]
+func (h *handler) listEvents(ctx server.RequestContext) (any, error) {
+	request := listEventsRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	events, err := h.service.ListEvents(ctx.Context(), request.id)
+	if errors.Is(err, message.ErrMessageDoesNotValidForListEvents) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, message.ErrMessageNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Message not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.ListEvents(): %w", err)
+	}
+
+	return eventsToListEventsResponse(events), nil
+}
+
+func eventsToListEventsResponse(events []message.Event) *listEventsResponse {
+	response := listEventsResponse{
+		Items: make([]listEventsResponseItem, 0),
+	}
+
+	for _, event := range events {
+		response.Items = append(response.Items, *eventToListEventsResponseItem(event))
+	}
+
+	return &response
+}
+
+func eventToListEventsResponseItem(event message.Event) *listEventsResponseItem {
+	item := listEventsResponseItem{
+		ID:   event.ID,
+		From: string(event.From),
+		To:   string(event.To),
+	}
+
+	if !event.CreatedAt.IsZero() {
+		item.CreatedAt = event.CreatedAt.Format(time.RFC3339)
+	}
+
+	return &item
+}
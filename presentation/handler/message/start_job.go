@@ -1,22 +1,28 @@
 package message
 
 import (
+	"fmt"
+
 	"messager/infrastructure/server"
 )
 
 type startJobResponse struct {
-	Started bool `json:"started" example:true`
+	Started bool `json:"started" example:"true"`
 }
 
-// @Summary Start the message sending job
-// @Description Starts the background job that sends pending messages
+// @Summary Start sending the messages of the tenant
+// @Description Resumes sending the pending messages of the caller's tenant. The messages of other tenants are not
+// @Description affected.
 // @Tags messages
 // @Produce json
 // @Success 200 {object} startJobResponse
 // @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
 // @Router /messages/jobs [post]
 func (h *handler) startJob(ctx server.RequestContext) (any, error) {
-	h.job.Start()
+	if err := h.tenants.Resume(ctx.Context()); err != nil {
+		return nil, fmt.Errorf("handler.tenants.Resume(): %w", err)
+	}
 
 	return startJobResponse{
 		Started: true,
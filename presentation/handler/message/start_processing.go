@@ -0,0 +1,30 @@
+package message
+
+import (
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+// @Summary Start the message job
+// @Description Starts the background job that claims the messages of every tenant for sending, if it is not running.
+// @Description Only the default tenant, the operator of messager, may start it.
+// @Tags messages
+// @Produce json
+// @Success 200 {object} startJobResponse
+// @Failure 403 {object} server.ErrorResponse "Forbidden"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /jobs [post]
+func (h *handler) startProcessing(ctx server.RequestContext) (any, error) {
+	current, ok := tenant.FromContext(ctx.Context())
+
+	if !ok || !current.IsDefault() {
+		return nil, ctx.NewError(server.StatusForbidden, "Forbidden.", current.NewErrTenantForbidden())
+	}
+
+	h.job.Start()
+
+	return startJobResponse{
+		Started: true,
+	}, nil
+}
@@ -1,22 +1,28 @@
 package message
 
 import (
+	"fmt"
+
 	"messager/infrastructure/server"
 )
 
 type stopJobResponse struct {
-	Stopped bool `json:"stopped" example:true`
+	Stopped bool `json:"stopped" example:"true"`
 }
 
-// @Summary Stop the message sending job
-// @Description Stops the background job that sends pending messages
+// @Summary Stop sending the messages of the tenant
+// @Description Pauses sending the pending messages of the caller's tenant. Messages are still accepted and are sent once
+// @Description the job is started again. The messages of other tenants are not affected.
 // @Tags messages
 // @Produce json
 // @Success 200 {object} stopJobResponse
 // @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
 // @Router /messages/jobs [delete]
 func (h *handler) stopJob(ctx server.RequestContext) (any, error) {
-	h.job.Stop()
+	if err := h.tenants.Pause(ctx.Context()); err != nil {
+		return nil, fmt.Errorf("handler.tenants.Pause(): %w", err)
+	}
 
 	return stopJobResponse{
 		Stopped: true,
@@ -0,0 +1,31 @@
+package message
+
+import (
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+// @Summary Stop the message job
+// @Description Stops the background job that claims the messages of every tenant for sending. Messages are still
+// @Description accepted and are sent once the job is started again. Only the default tenant, the operator of messager,
+// @Description may stop it.
+// @Tags messages
+// @Produce json
+// @Success 200 {object} stopJobResponse
+// @Failure 403 {object} server.ErrorResponse "Forbidden"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /jobs [delete]
+func (h *handler) stopProcessing(ctx server.RequestContext) (any, error) {
+	current, ok := tenant.FromContext(ctx.Context())
+
+	if !ok || !current.IsDefault() {
+		return nil, ctx.NewError(server.StatusForbidden, "Forbidden.", current.NewErrTenantForbidden())
+	}
+
+	h.job.Stop()
+
+	return stopJobResponse{
+		Stopped: true,
+	}, nil
+}
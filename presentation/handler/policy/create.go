@@ -0,0 +1,40 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/policy"
+	"messager/infrastructure/server"
+)
+
+// @Summary Create a content policy
+// @Description Create a set of content rules every new message must satisfy: forbidden words (case insensitive,
+// @Description whole words), forbidden regular expressions, domains links may point to, a footer content must end
+// @Description with and a maximum number of links. Rules that are left empty are not enforced.
+// @Tags content-policies
+// @Accept json
+// @Produce json
+// @Param policy body policyRequest true "Content policy to be created"
+// @Success 201 {object} policyResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /content-policies [post]
+func (h *handler) create(ctx server.RequestContext) (any, error) {
+	var request policyRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	newPolicy, err := h.service.Create(ctx.Context(), request.toPolicy())
+	if errors.Is(err, policy.ErrPolicyDoesNotValidForCreate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Create(): %w", err)
+	}
+
+	return policyToPolicyResponse(*newPolicy), nil
+}
@@ -0,0 +1,49 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/policy"
+	"messager/infrastructure/server"
+)
+
+type deleteRequest struct {
+	id string
+}
+
+type deleteResponse struct {
+	Deleted bool `json:"deleted" example:"true"`
+}
+
+// @Summary Delete a content policy
+// @Description Delete a content policy by ID. Messages already created are kept.
+// @Tags content-policies
+// @Produce json
+// @Param id path string true "Content policy ID"
+// @Success 200 {object} deleteResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid content policy ID"
+// @Failure 404 {object} server.ErrorResponse "Content policy not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /content-policies/{id} [delete]
+func (h *handler) delete(ctx server.RequestContext) (any, error) {
+	request := deleteRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	err := h.service.Delete(ctx.Context(), request.id)
+	if errors.Is(err, policy.ErrPolicyDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, policy.ErrPolicyNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Content policy not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Delete(): %w", err)
+	}
+
+	return deleteResponse{
+		Deleted: true,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package policy
+
+import (
+	"messager/domain/policy"
+	service "messager/domain/policy"
+	"messager/infrastructure/server"
+)
+
+type Handler interface {
+	create(ctx server.RequestContext) (any, error)
+}
+
+type handler struct {
+	service service.Service
+}
+
+func New(router server.Router, service policy.Service) Handler {
+	h := handler{
+		service: service,
+	}
+
+	router.AddRoute("POST /content-policies", server.RoleAdmin, h.create)
+	router.AddRoute("GET /content-policies", server.RoleReadOnly, h.list)
+	router.AddRoute("DELETE /content-policies/{id}", server.RoleAdmin, h.delete)
+
+	return &h
+}
@@ -0,0 +1,41 @@
+package policy
+
+import (
+	"fmt"
+
+	"messager/domain/policy"
+	"messager/infrastructure/server"
+)
+
+type listResponse struct {
+	Items []policyResponse `json:"items"`
+}
+
+// @Summary List content policies
+// @Description Get the content policies stored in the database. Policies from the configuration are not listed.
+// @Tags content-policies
+// @Produce json
+// @Success 200 {object} listResponse
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /content-policies [get]
+func (h *handler) list(ctx server.RequestContext) (any, error) {
+	policies, err := h.service.List(ctx.Context())
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.List(): %w", err)
+	}
+
+	return policiesToListResponse(policies), nil
+}
+
+func policiesToListResponse(policies []policy.Policy) *listResponse {
+	response := listResponse{
+		Items: make([]policyResponse, 0),
+	}
+
+	for _, policy := range policies {
+		response.Items = append(response.Items, *policyToPolicyResponse(policy))
+	}
+
+	return &response
+}
@@ -0,0 +1,64 @@
+package policy
+
+import (
+	"time"
+
+	"messager/domain/policy"
+)
+
+type policyRequest struct {
+	Name              string   `json:"name" example:"marketing"`
+	ForbiddenWords    []string `json:"forbiddenWords,omitempty" example:"free money"`
+	ForbiddenPatterns []string `json:"forbiddenPatterns,omitempty" example:"(?i)guaranteed\\s+win"`
+	AllowedDomains    []string `json:"allowedDomains,omitempty" example:"example.com"`
+	RequiredFooter    string   `json:"requiredFooter,omitempty" example:"Reply STOP to opt out."`
+	MaxLinks          int      `json:"maxLinks,omitempty" example:"1"`
+}
+
+type policyResponse struct {
+	ID                string   `json:"id" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt         string   `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Name              string   `json:"name" example:"marketing"`
+	ForbiddenWords    []string `json:"forbiddenWords" example:"free money"`
+	ForbiddenPatterns []string `json:"forbiddenPatterns" example:"(?i)guaranteed\\s+win"`
+	AllowedDomains    []string `json:"allowedDomains" example:"example.com"`
+	RequiredFooter    string   `json:"requiredFooter,omitempty" example:"Reply STOP to opt out."`
+	MaxLinks          int      `json:"maxLinks,omitempty" example:"1"`
+}
+
+func (r *policyRequest) toPolicy() policy.Policy {
+	return policy.Policy{
+		Name:              r.Name,
+		ForbiddenWords:    r.ForbiddenWords,
+		ForbiddenPatterns: r.ForbiddenPatterns,
+		AllowedDomains:    r.AllowedDomains,
+		RequiredFooter:    r.RequiredFooter,
+		MaxLinks:          r.MaxLinks,
+	}
+}
+
+func policyToPolicyResponse(policy policy.Policy) *policyResponse {
+	response := policyResponse{
+		ID:                policy.ID,
+		Name:              policy.Name,
+		ForbiddenWords:    nonNil(policy.ForbiddenWords),
+		ForbiddenPatterns: nonNil(policy.ForbiddenPatterns),
+		AllowedDomains:    nonNil(policy.AllowedDomains),
+		RequiredFooter:    policy.RequiredFooter,
+		MaxLinks:          policy.MaxLinks,
+	}
+
+	if !policy.CreatedAt.IsZero() {
+		response.CreatedAt = policy.CreatedAt.Format(time.RFC3339)
+	}
+
+	return &response
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+
+	return values
+}
@@ -0,0 +1,44 @@
+package sender
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/sender"
+	"messager/infrastructure/server"
+)
+
+// @Summary Add a sender to the pool
+// @Description Add a phone number (NUMBER) or an alphanumeric sender ID of up to 11 characters (ALPHANUMERIC) to the
+// @Description pool messages are sent from. A sender with countries is only used for recipients in them, others are
+// @Description used for every recipient. Numbers are normalized to E.164.
+// @Tags senders
+// @Accept json
+// @Produce json
+// @Param sender body senderRequest true "Sender to be added"
+// @Success 201 {object} senderResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 409 {object} server.ErrorResponse "Sender already exists"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /senders [post]
+func (h *handler) create(ctx server.RequestContext) (any, error) {
+	var request senderRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	newSender, err := h.service.Create(ctx.Context(), request.toSender())
+	if errors.Is(err, sender.ErrSenderDoesNotValidForCreate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, sender.ErrSenderAlreadyExists) {
+		return nil, ctx.NewError(server.StatusConflict, "Sender already exists.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Create(): %w", err)
+	}
+
+	return senderToSenderResponse(*newSender), nil
+}
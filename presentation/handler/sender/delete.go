@@ -0,0 +1,49 @@
+package sender
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/sender"
+	"messager/infrastructure/server"
+)
+
+type deleteRequest struct {
+	id string
+}
+
+type deleteResponse struct {
+	Deleted bool `json:"deleted" example:"true"`
+}
+
+// @Summary Remove a sender from the pool
+// @Description Remove a sender by ID. Messages already created keep the sender they were assigned.
+// @Tags senders
+// @Produce json
+// @Param id path string true "Sender ID"
+// @Success 200 {object} deleteResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid sender ID"
+// @Failure 404 {object} server.ErrorResponse "Sender not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /senders/{id} [delete]
+func (h *handler) delete(ctx server.RequestContext) (any, error) {
+	request := deleteRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	err := h.service.Delete(ctx.Context(), request.id)
+	if errors.Is(err, sender.ErrSenderDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, sender.ErrSenderNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Sender not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Delete(): %w", err)
+	}
+
+	return deleteResponse{
+		Deleted: true,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package sender
+
+import (
+	"messager/domain/sender"
+	service "messager/domain/sender"
+	"messager/infrastructure/server"
+)
+
+type Handler interface {
+	create(ctx server.RequestContext) (any, error)
+}
+
+type handler struct {
+	service service.Service
+}
+
+func New(router server.Router, service sender.Service) Handler {
+	h := handler{
+		service: service,
+	}
+
+	router.AddRoute("POST /senders", server.RoleAdmin, h.create)
+	router.AddRoute("GET /senders", server.RoleReadOnly, h.list)
+	router.AddRoute("DELETE /senders/{id}", server.RoleAdmin, h.delete)
+
+	return &h
+}
@@ -0,0 +1,41 @@
+package sender
+
+import (
+	"fmt"
+
+	"messager/domain/sender"
+	"messager/infrastructure/server"
+)
+
+type listResponse struct {
+	Items []senderResponse `json:"items"`
+}
+
+// @Summary List the sender pool
+// @Description Get the senders messages are sent from, in the order the COUNTRY strategy considers them.
+// @Tags senders
+// @Produce json
+// @Success 200 {object} listResponse
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /senders [get]
+func (h *handler) list(ctx server.RequestContext) (any, error) {
+	senders, err := h.service.List(ctx.Context())
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.List(): %w", err)
+	}
+
+	return sendersToListResponse(senders), nil
+}
+
+func sendersToListResponse(senders []sender.Sender) *listResponse {
+	response := listResponse{
+		Items: make([]senderResponse, 0),
+	}
+
+	for _, sender := range senders {
+		response.Items = append(response.Items, *senderToSenderResponse(sender))
+	}
+
+	return &response
+}
@@ -0,0 +1,48 @@
+package sender
+
+import (
+	"time"
+
+	"messager/domain/sender"
+)
+
+type senderRequest struct {
+	Type      string   `json:"type" example:"NUMBER"`
+	Value     string   `json:"value" example:"+905551234567"`
+	Countries []string `json:"countries,omitempty" example:"TR"`
+}
+
+type senderResponse struct {
+	ID        string   `json:"id" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt string   `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Type      string   `json:"type" example:"NUMBER"`
+	Value     string   `json:"value" example:"+905551234567"`
+	Countries []string `json:"countries" example:"TR"`
+}
+
+func (r *senderRequest) toSender() sender.Sender {
+	return sender.Sender{
+		Type:      sender.Type(r.Type),
+		Value:     r.Value,
+		Countries: r.Countries,
+	}
+}
+
+func senderToSenderResponse(sender sender.Sender) *senderResponse {
+	response := senderResponse{
+		ID:        sender.ID,
+		Type:      string(sender.Type),
+		Value:     sender.Value,
+		Countries: sender.Countries,
+	}
+
+	if response.Countries == nil {
+		response.Countries = make([]string, 0)
+	}
+
+	if !sender.CreatedAt.IsZero() {
+		response.CreatedAt = sender.CreatedAt.Format(time.RFC3339)
+	}
+
+	return &response
+}
@@ -0,0 +1,39 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/infrastructure/server"
+)
+
+// @Summary Create a new template
+// @Description Create a message template with one variant per locale. Variant content may contain {{name}} placeholders.
+// @Description defaultLocale is the variant used when no other variant matches, and defaults to the first variant.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param template body templateRequest true "Template object to be created"
+// @Success 201 {object} templateResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /templates [post]
+func (h *handler) create(ctx server.RequestContext) (any, error) {
+	var request templateRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	newTemplate, err := h.service.Create(ctx.Context(), request.toTemplate(""))
+	if errors.Is(err, template.ErrTemplateDoesNotValidForCreate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Create(): %w", err)
+	}
+
+	return templateToTemplateResponse(*newTemplate), nil
+}
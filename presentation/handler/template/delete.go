@@ -0,0 +1,49 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/infrastructure/server"
+)
+
+type deleteRequest struct {
+	id string
+}
+
+type deleteResponse struct {
+	Deleted bool `json:"deleted" example:"true"`
+}
+
+// @Summary Delete a template
+// @Description Delete a message template by ID. Messages already created from it are kept.
+// @Tags templates
+// @Produce json
+// @Param id path string true "Template ID"
+// @Success 200 {object} deleteResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid template ID"
+// @Failure 404 {object} server.ErrorResponse "Template not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /templates/{id} [delete]
+func (h *handler) delete(ctx server.RequestContext) (any, error) {
+	request := deleteRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	err := h.service.Delete(ctx.Context(), request.id)
+	if errors.Is(err, template.ErrTemplateDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, template.ErrTemplateNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Template not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Delete(): %w", err)
+	}
+
+	return deleteResponse{
+		Deleted: true,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/infrastructure/server"
+)
+
+type getRequest struct {
+	id string
+}
+
+// @Summary Get a template
+// @Description Get a message template by ID
+// @Tags templates
+// @Produce json
+// @Param id path string true "Template ID"
+// @Success 200 {object} templateResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid template ID"
+// @Failure 404 {object} server.ErrorResponse "Template not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /templates/{id} [get]
+func (h *handler) get(ctx server.RequestContext) (any, error) {
+	request := getRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	foundTemplate, err := h.service.Get(ctx.Context(), request.id)
+	if errors.Is(err, template.ErrTemplateDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, template.ErrTemplateNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Template not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Get(): %w", err)
+	}
+
+	return templateToTemplateResponse(*foundTemplate), nil
+}
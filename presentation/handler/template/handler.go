@@ -0,0 +1,30 @@
+package template
+
+import (
+	"messager/domain/template"
+	service "messager/domain/template"
+	"messager/infrastructure/server"
+)
+
+type Handler interface {
+	create(ctx server.RequestContext) (any, error)
+}
+
+type handler struct {
+	service service.Service
+}
+
+func New(router server.Router, service template.Service) Handler {
+	h := handler{
+		service: service,
+	}
+
+	router.AddRoute("POST /templates", server.RoleAdmin, h.create)
+	router.AddRoute("GET /templates", server.RoleReadOnly, h.list)
+	router.AddRoute("GET /templates/{id}", server.RoleReadOnly, h.get)
+	router.AddRoute("PUT /templates/{id}", server.RoleAdmin, h.update)
+	router.AddRoute("DELETE /templates/{id}", server.RoleAdmin, h.delete)
+	router.AddRoute("POST /templates/{id}/preview", server.RoleReadOnly, h.preview)
+
+	return &h
+}
@@ -0,0 +1,41 @@
+package template
+
+import (
+	"fmt"
+
+	"messager/domain/template"
+	"messager/infrastructure/server"
+)
+
+type listResponse struct {
+	Items []templateResponse `json:"items"`
+}
+
+// @Summary List templates
+// @Description Get all message templates
+// @Tags templates
+// @Produce json
+// @Success 200 {object} listResponse
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /templates [get]
+func (h *handler) list(ctx server.RequestContext) (any, error) {
+	templates, err := h.service.List(ctx.Context())
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.List(): %w", err)
+	}
+
+	return templatesToListResponse(templates), nil
+}
+
+func templatesToListResponse(templates []template.Template) *listResponse {
+	response := listResponse{
+		Items: make([]templateResponse, 0),
+	}
+
+	for _, template := range templates {
+		response.Items = append(response.Items, *templateToTemplateResponse(template))
+	}
+
+	return &response
+}
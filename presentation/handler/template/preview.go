@@ -0,0 +1,62 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/infrastructure/server"
+)
+
+type previewRequest struct {
+	Locale    string            `json:"locale,omitempty" example:"tr"`
+	Phone     string            `json:"phone,omitempty" example:"+905551234567"`
+	Variables map[string]string `json:"variables"`
+}
+
+type previewResponse struct {
+	Locale   string `json:"locale" example:"en"`
+	Content  string `json:"content" example:"Your code is 123456."`
+	Encoding string `json:"encoding" example:"GSM-7"`
+	Segments int    `json:"segments" example:"1"`
+}
+
+// @Summary Preview a template
+// @Description Render a template with variables and return its content and segment count.
+// @Description The variant is selected by locale, or by the phone's region when locale is omitted.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Template ID"
+// @Param preview body previewRequest true "Variables and recipient to render the template for"
+// @Success 200 {object} previewResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 404 {object} server.ErrorResponse "Template not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /templates/{id}/preview [post]
+func (h *handler) preview(ctx server.RequestContext) (any, error) {
+	var request previewRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	preview, err := h.service.Preview(ctx.Context(), ctx.GetPathValue("id"), request.Locale, request.Phone, request.Variables)
+	if errors.Is(err, template.ErrTemplateDoesNotValidForFind) || errors.Is(err, template.ErrTemplateVariablesMissing) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, template.ErrTemplateNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Template not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Preview(): %w", err)
+	}
+
+	return &previewResponse{
+		Locale:   preview.Locale,
+		Content:  preview.Content,
+		Encoding: string(preview.Encoding),
+		Segments: preview.Segments,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package template
+
+import (
+	"time"
+
+	"messager/domain/template"
+)
+
+type templateRequest struct {
+	Name          string           `json:"name" example:"otp"`
+	DefaultLocale string           `json:"defaultLocale,omitempty" example:"en"`
+	Variants      []variantRequest `json:"variants"`
+}
+
+type variantRequest struct {
+	Locale  string `json:"locale" example:"en"`
+	Content string `json:"content" example:"Your code is {{code}}."`
+}
+
+type templateResponse struct {
+	ID            string            `json:"id" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt     string            `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	UpdatedAt     string            `json:"updatedAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Name          string            `json:"name" example:"otp"`
+	DefaultLocale string            `json:"defaultLocale" example:"en"`
+	Variants      []variantResponse `json:"variants"`
+}
+
+type variantResponse struct {
+	Locale    string   `json:"locale" example:"en"`
+	Content   string   `json:"content" example:"Your code is {{code}}."`
+	Variables []string `json:"variables" example:"code"`
+}
+
+func (r *templateRequest) toTemplate(id string) template.Template {
+	newTemplate := template.Template{
+		ID:            id,
+		Name:          r.Name,
+		DefaultLocale: r.DefaultLocale,
+		Variants:      make([]template.Variant, 0, len(r.Variants)),
+	}
+
+	for _, variant := range r.Variants {
+		newTemplate.Variants = append(newTemplate.Variants, template.Variant{
+			Locale:  variant.Locale,
+			Content: variant.Content,
+		})
+	}
+
+	return newTemplate
+}
+
+func templateToTemplateResponse(template template.Template) *templateResponse {
+	response := templateResponse{
+		ID:            template.ID,
+		Name:          template.Name,
+		DefaultLocale: template.DefaultLocale,
+		Variants:      make([]variantResponse, 0, len(template.Variants)),
+	}
+
+	if !template.CreatedAt.IsZero() {
+		response.CreatedAt = template.CreatedAt.Format(time.RFC3339)
+	}
+
+	if !template.UpdatedAt.IsZero() {
+		response.UpdatedAt = template.UpdatedAt.Format(time.RFC3339)
+	}
+
+	for _, variant := range template.Variants {
+		variables := variant.Variables()
+		if variables == nil {
+			variables = make([]string, 0)
+		}
+
+		response.Variants = append(response.Variants, variantResponse{
+			Locale:    variant.Locale,
+			Content:   variant.Content,
+			Variables: variables,
+		})
+	}
+
+	return &response
+}
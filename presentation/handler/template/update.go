@@ -0,0 +1,43 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/template"
+	"messager/infrastructure/server"
+)
+
+// @Summary Update a template
+// @Description Replace the name, default locale and variants of a message template
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Template ID"
+// @Param template body templateRequest true "Template object to be updated"
+// @Success 200 {object} templateResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 404 {object} server.ErrorResponse "Template not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /templates/{id} [put]
+func (h *handler) update(ctx server.RequestContext) (any, error) {
+	var request templateRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	updatedTemplate, err := h.service.Update(ctx.Context(), request.toTemplate(ctx.GetPathValue("id")))
+	if errors.Is(err, template.ErrTemplateDoesNotValidForUpdate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, template.ErrTemplateNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Template not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Update(): %w", err)
+	}
+
+	return templateToTemplateResponse(*updatedTemplate), nil
+}
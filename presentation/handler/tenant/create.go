@@ -0,0 +1,44 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+// @Summary Create a tenant
+// @Description Create a tenant messages, templates, campaigns, senders, content policies and consents are scoped to.
+// @Description Phone numbers of the tenant are parsed in its default region and a daily quota of zero is unlimited.
+// @Description Only the default tenant may create tenants.
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Param tenant body tenantRequest true "Tenant to be created"
+// @Success 201 {object} tenantResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 403 {object} server.ErrorResponse "Tenant is not allowed to manage tenants"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /tenants [post]
+func (h *handler) create(ctx server.RequestContext) (any, error) {
+	var request tenantRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	newTenant, err := h.service.Create(ctx.Context(), request.toTenant(""))
+	if errors.Is(err, tenant.ErrTenantForbidden) {
+		return nil, ctx.NewError(server.StatusForbidden, "Forbidden.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantDoesNotValidForCreate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Create(): %w", err)
+	}
+
+	return tenantToTenantResponse(*newTenant), nil
+}
@@ -0,0 +1,43 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+type getRequest struct {
+	id string
+}
+
+// @Summary Get a tenant
+// @Description Get a tenant by ID. Tenants other than the default one may only get themselves.
+// @Tags tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Success 200 {object} tenantResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid tenant ID"
+// @Failure 404 {object} server.ErrorResponse "Tenant not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /tenants/{id} [get]
+func (h *handler) get(ctx server.RequestContext) (any, error) {
+	request := getRequest{
+		id: ctx.GetPathValue("id"),
+	}
+
+	foundTenant, err := h.service.Get(ctx.Context(), request.id)
+	if errors.Is(err, tenant.ErrTenantDoesNotValidForFind) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Tenant not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Get(): %w", err)
+	}
+
+	return tenantToTenantResponse(*foundTenant), nil
+}
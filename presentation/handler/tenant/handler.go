@@ -0,0 +1,28 @@
+package tenant
+
+import (
+	"messager/domain/tenant"
+	service "messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+type Handler interface {
+	create(ctx server.RequestContext) (any, error)
+}
+
+type handler struct {
+	service service.Service
+}
+
+func New(router server.Router, service tenant.Service) Handler {
+	h := handler{
+		service: service,
+	}
+
+	router.AddRoute("POST /tenants", server.RoleAdmin, h.create)
+	router.AddRoute("GET /tenants", server.RoleAdmin, h.list)
+	router.AddRoute("GET /tenants/{id}", server.RoleReadOnly, h.get)
+	router.AddRoute("PUT /tenants/{id}", server.RoleAdmin, h.update)
+
+	return &h
+}
@@ -0,0 +1,46 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+type listResponse struct {
+	Items []tenantResponse `json:"items"`
+}
+
+// @Summary List tenants
+// @Description Get every tenant. Only the default tenant may list tenants.
+// @Tags tenants
+// @Produce json
+// @Success 200 {object} listResponse
+// @Failure 403 {object} server.ErrorResponse "Tenant is not allowed to manage tenants"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /tenants [get]
+func (h *handler) list(ctx server.RequestContext) (any, error) {
+	tenants, err := h.service.List(ctx.Context())
+	if errors.Is(err, tenant.ErrTenantForbidden) {
+		return nil, ctx.NewError(server.StatusForbidden, "Forbidden.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.List(): %w", err)
+	}
+
+	return tenantsToListResponse(tenants), nil
+}
+
+func tenantsToListResponse(tenants []tenant.Tenant) *listResponse {
+	response := listResponse{
+		Items: make([]tenantResponse, 0),
+	}
+
+	for _, tenant := range tenants {
+		response.Items = append(response.Items, *tenantToTenantResponse(tenant))
+	}
+
+	return &response
+}
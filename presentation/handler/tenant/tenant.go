@@ -0,0 +1,52 @@
+package tenant
+
+import (
+	"time"
+
+	"messager/domain/tenant"
+)
+
+type tenantRequest struct {
+	Name          string `json:"name" example:"Shop"`
+	DefaultRegion string `json:"defaultRegion,omitempty" example:"TR"`
+	DailyQuota    int    `json:"dailyQuota,omitempty" example:"10000"`
+}
+
+type tenantResponse struct {
+	ID            string `json:"id" example:"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`
+	CreatedAt     string `json:"createdAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	UpdatedAt     string `json:"updatedAt,omitempty" example:"2023-10-27T10:00:00Z"`
+	Name          string `json:"name" example:"Shop"`
+	DefaultRegion string `json:"defaultRegion" example:"TR"`
+	DailyQuota    int    `json:"dailyQuota" example:"10000"`
+	Paused        bool   `json:"paused" example:"false"`
+}
+
+func (r *tenantRequest) toTenant(id string) tenant.Tenant {
+	return tenant.Tenant{
+		ID:            id,
+		Name:          r.Name,
+		DefaultRegion: r.DefaultRegion,
+		DailyQuota:    r.DailyQuota,
+	}
+}
+
+func tenantToTenantResponse(tenant tenant.Tenant) *tenantResponse {
+	response := tenantResponse{
+		ID:            tenant.ID,
+		Name:          tenant.Name,
+		DefaultRegion: tenant.DefaultRegion,
+		DailyQuota:    tenant.DailyQuota,
+		Paused:        tenant.Paused,
+	}
+
+	if !tenant.CreatedAt.IsZero() {
+		response.CreatedAt = tenant.CreatedAt.Format(time.RFC3339)
+	}
+
+	if !tenant.UpdatedAt.IsZero() {
+		response.UpdatedAt = tenant.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return &response
+}
@@ -0,0 +1,48 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+
+	"messager/domain/tenant"
+	"messager/infrastructure/server"
+)
+
+// @Summary Update a tenant
+// @Description Replace the name, default region and daily quota of a tenant. Only the default tenant may update
+// @Description tenants.
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Param tenant body tenantRequest true "Tenant to be updated"
+// @Success 200 {object} tenantResponse
+// @Failure 400 {object} server.ErrorResponse "Invalid request"
+// @Failure 403 {object} server.ErrorResponse "Tenant is not allowed to manage tenants"
+// @Failure 404 {object} server.ErrorResponse "Tenant not found"
+// @Failure 500 {object} server.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /tenants/{id} [put]
+func (h *handler) update(ctx server.RequestContext) (any, error) {
+	var request tenantRequest
+
+	if err := ctx.ParseJSONBody(&request); err != nil {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+
+	updatedTenant, err := h.service.Update(ctx.Context(), request.toTenant(ctx.GetPathValue("id")))
+	if errors.Is(err, tenant.ErrTenantForbidden) {
+		return nil, ctx.NewError(server.StatusForbidden, "Forbidden.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantDoesNotValidForUpdate) {
+		return nil, ctx.NewError(server.StatusBadRequest, "Invalid request.", err)
+	}
+	if errors.Is(err, tenant.ErrTenantNotFound) {
+		return nil, ctx.NewError(server.StatusNotFound, "Tenant not found.", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("handler.service.Update(): %w", err)
+	}
+
+	return tenantToTenantResponse(*updatedTenant), nil
+}
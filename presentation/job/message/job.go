@@ -1,6 +1,7 @@
 package message
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -13,7 +14,7 @@ type Job interface {
 }
 
 type job struct {
-	service  message.Service
+	task     func(ctx context.Context) error
 	ticker   *time.Ticker
 	duration time.Duration
 	stop     chan struct{}
@@ -23,8 +24,20 @@ type job struct {
 }
 
 func New(service message.Service, interval time.Duration, onError func(err error)) Job {
+	return newJob(service.Process, interval, onError)
+}
+
+func NewExpiration(service message.Service, interval time.Duration, onError func(err error)) Job {
+	return newJob(service.Expire, interval, onError)
+}
+
+func NewRelay(service message.Service, interval time.Duration, onError func(err error)) Job {
+	return newJob(service.Relay, interval, onError)
+}
+
+func newJob(task func(ctx context.Context) error, interval time.Duration, onError func(err error)) Job {
 	j := job{
-		service:  service,
+		task:     task,
 		ticker:   nil,
 		duration: interval,
 		stop:     make(chan struct{}),
@@ -3,6 +3,8 @@ package message
 import (
 	"context"
 	"time"
+
+	"messager/domain/tenant"
 )
 
 func (j *job) Start() {
@@ -10,6 +12,9 @@ func (j *job) Start() {
 		return
 	}
 
+	// Jobs work across tenants, so they act for the default tenant.
+	ctx := tenant.NewContext(context.Background(), tenant.Default())
+
 	j.ticker = time.NewTicker(j.duration)
 	j.stop = make(chan struct{})
 	j.start = true
@@ -21,7 +26,7 @@ func (j *job) Start() {
 				j.wg.Add(1)
 				defer j.wg.Done()
 
-				if err := j.service.Process(context.Background()); err != nil {
+				if err := j.task(ctx); err != nil {
 					j.onError(err)
 				}
 			case <-j.stop: